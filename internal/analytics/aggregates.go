@@ -332,6 +332,23 @@ func GetSessionsByFilter(stats *storage.Statistics, filter AggregateFilter) []st
 	return sessions
 }
 
+// BestScoresByMode returns the highest session score for each mode name,
+// counting only sessions played with the question timer on (questionTimer)
+// or only those without it, since the two score differently. Modes without
+// such sessions are absent from the map.
+func BestScoresByMode(stats *storage.Statistics, questionTimer bool) map[string]int {
+	best := make(map[string]int)
+	for _, session := range stats.Sessions {
+		if (session.QuestionLimitMs > 0) != questionTimer {
+			continue
+		}
+		if score, ok := best[session.Mode]; !ok || session.Score > score {
+			best[session.Mode] = session.Score
+		}
+	}
+	return best
+}
+
 // GetOperationsByCategory returns operations that belong to a specific category.
 // If category is empty, returns all operations.
 func GetOperationsByCategory(stats *storage.Statistics, category string) []string {
@@ -535,3 +535,26 @@ func TestComputeExtendedAggregates_TotalResponseTime(t *testing.T) {
 		t.Errorf("AvgResponseTimeMs = %d, want 2000", agg.AvgResponseTimeMs)
 	}
 }
+
+func TestBestScoresByMode(t *testing.T) {
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{
+		{Mode: "Addition", Score: 120},
+		{Mode: "Addition", Score: 340},
+		{Mode: "Squares", Score: 0},
+		{Mode: "Addition", Score: 200},
+		{Mode: "Addition", Score: 900, QuestionLimitMs: 5000},
+	}}
+
+	best := BestScoresByMode(stats, false)
+	if len(best) != 2 || best["Addition"] != 340 {
+		t.Errorf("BestScoresByMode() = %v, want Addition 340 and Squares 0", best)
+	}
+	if score, ok := best["Squares"]; !ok || score != 0 {
+		t.Errorf("Squares = %d, %v; want 0, true", score, ok)
+	}
+
+	timed := BestScoresByMode(stats, true)
+	if len(timed) != 1 || timed["Addition"] != 900 {
+		t.Errorf("BestScoresByMode(timer) = %v, want only Addition 900", timed)
+	}
+}
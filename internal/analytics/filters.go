@@ -1,6 +1,8 @@
 package analytics
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gurselcakar/arithmego/internal/storage"
@@ -51,6 +53,34 @@ func (t TimePeriod) String() string {
 	}
 }
 
+// Key returns the short name used on the command line ("all", "7d", ...).
+func (t TimePeriod) Key() string {
+	switch t {
+	case TimePeriod7Days:
+		return "7d"
+	case TimePeriod14Days:
+		return "14d"
+	case TimePeriod30Days:
+		return "30d"
+	case TimePeriod90Days:
+		return "90d"
+	default:
+		return "all"
+	}
+}
+
+// ParseTimePeriod parses a short period name as returned by [TimePeriod.Key].
+func ParseTimePeriod(s string) (TimePeriod, error) {
+	keys := make([]string, 0, len(AllTimePeriods()))
+	for _, t := range AllTimePeriods() {
+		if strings.EqualFold(s, t.Key()) {
+			return t, nil
+		}
+		keys = append(keys, t.Key())
+	}
+	return TimePeriodAllTime, fmt.Errorf("unknown period %q (valid: %s)", s, strings.Join(keys, ", "))
+}
+
 // AllTimePeriods returns all available time period options.
 func AllTimePeriods() []TimePeriod {
 	return []TimePeriod{
@@ -73,6 +103,10 @@ type AggregateFilter struct {
 	// Time range filter
 	TimePeriod TimePeriod
 
+	// Since filter: zero for no lower bound, otherwise sessions before it are excluded.
+	// Combines with TimePeriod (the later cutoff wins).
+	Since time.Time
+
 	// Mode filter: "" for all, or specific mode name
 	Mode string
 
@@ -85,10 +119,71 @@ func (f AggregateFilter) IsEmpty() bool {
 	return f.Category == "" &&
 		f.Difficulty == "" &&
 		f.TimePeriod == TimePeriodAllTime &&
+		f.Since.IsZero() &&
 		f.Mode == "" &&
 		f.Operation == ""
 }
 
+// SessionQuery returns the session-level part of the filter for pushing down
+// into a storage backend. Category and operation filters apply to individual
+// questions and are left to [ComputeFilteredAggregates] and friends.
+func (f AggregateFilter) SessionQuery() storage.SessionQuery {
+	since := f.Since
+	if f.TimePeriod != TimePeriodAllTime {
+		if cutoff := f.TimePeriod.Cutoff(); cutoff.After(since) {
+			since = cutoff
+		}
+	}
+	return storage.SessionQuery{
+		Since:      since,
+		Difficulty: f.Difficulty,
+		Mode:       f.Mode,
+	}
+}
+
+// ParseAggregateFilter builds a filter from user input such as command-line
+// flags or query parameters. Empty values mean "all"; names are matched
+// case-insensitively and period is a [TimePeriod.Key].
+func ParseAggregateFilter(period, difficulty, category string) (AggregateFilter, error) {
+	var filter AggregateFilter
+
+	if period != "" {
+		p, err := ParseTimePeriod(period)
+		if err != nil {
+			return filter, err
+		}
+		filter.TimePeriod = p
+	}
+
+	var err error
+	if filter.Difficulty, err = matchOption("difficulty", difficulty, AllDifficulties()); err != nil {
+		return filter, err
+	}
+	if filter.Category, err = matchOption("category", category, AllCategories()); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// matchOption returns the option equal to value ignoring case.
+// Empty options (meaning "all") are skipped; an empty value returns "".
+func matchOption(name, value string, options []string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	var valid []string
+	for _, o := range options {
+		if o == "" {
+			continue
+		}
+		if strings.EqualFold(value, o) {
+			return o, nil
+		}
+		valid = append(valid, strings.ToLower(o))
+	}
+	return "", fmt.Errorf("unknown %s %q (valid: %s)", name, value, strings.Join(valid, ", "))
+}
+
 // operationCategories maps operation names to their categories.
 var operationCategories = map[string]string{
 	// Basic
@@ -152,6 +247,11 @@ func SessionMatchesFilter(s storage.SessionRecord, f AggregateFilter) bool {
 		}
 	}
 
+	// Check explicit start date
+	if !f.Since.IsZero() && s.Timestamp.Before(f.Since) {
+		return false
+	}
+
 	// Check difficulty
 	if f.Difficulty != "" && s.Difficulty != f.Difficulty {
 		return false
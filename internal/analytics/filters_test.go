@@ -291,6 +291,34 @@ func TestSessionMatchesFilter_TimePeriod(t *testing.T) {
 	}
 }
 
+func TestSessionMatchesFilter_Since(t *testing.T) {
+	now := time.Now()
+	session := storage.SessionRecord{Timestamp: now.AddDate(0, 0, -5)}
+
+	tests := []struct {
+		name   string
+		filter AggregateFilter
+		want   bool
+	}{
+		{"since before session", AggregateFilter{Since: now.AddDate(0, 0, -10)}, true},
+		{"since after session", AggregateFilter{Since: now.AddDate(0, 0, -2)}, false},
+		{"since with wider period", AggregateFilter{Since: now.AddDate(0, 0, -2), TimePeriod: TimePeriod30Days}, false},
+		{"period narrower than since", AggregateFilter{Since: now.AddDate(0, 0, -30), TimePeriod: TimePeriod7Days}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SessionMatchesFilter(session, tt.filter); got != tt.want {
+				t.Errorf("SessionMatchesFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if (AggregateFilter{Since: now}).IsEmpty() {
+		t.Error("filter with Since should not be empty")
+	}
+}
+
 func TestQuestionMatchesFilter(t *testing.T) {
 	question := storage.QuestionRecord{
 		Operation: "Addition",
@@ -342,3 +370,57 @@ func TestQuestionMatchesFilter_Categories(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateFilter_SessionQuery(t *testing.T) {
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := AggregateFilter{
+		Difficulty: "Hard",
+		Mode:       "Addition",
+		Since:      since,
+		Category:   "Basic",
+	}
+
+	q := f.SessionQuery()
+	if q.Difficulty != "Hard" || q.Mode != "Addition" || !q.Since.Equal(since) {
+		t.Errorf("SessionQuery() = %+v", q)
+	}
+
+	// The later of Since and the time period cutoff wins.
+	f.TimePeriod = TimePeriod7Days
+	q = f.SessionQuery()
+	if !q.Since.After(time.Now().AddDate(0, 0, -8)) {
+		t.Errorf("SessionQuery().Since = %v, want about 7 days ago", q.Since)
+	}
+}
+
+func TestParseTimePeriod(t *testing.T) {
+	for _, period := range AllTimePeriods() {
+		got, err := ParseTimePeriod(period.Key())
+		if err != nil || got != period {
+			t.Errorf("ParseTimePeriod(%q) = %v, %v; want %v", period.Key(), got, err, period)
+		}
+	}
+	if got, err := ParseTimePeriod("7D"); err != nil || got != TimePeriod7Days {
+		t.Errorf("ParseTimePeriod(\"7D\") = %v, %v", got, err)
+	}
+	if _, err := ParseTimePeriod("7 days"); err == nil {
+		t.Error("ParseTimePeriod(\"7 days\") should fail")
+	}
+}
+
+func TestParseAggregateFilter(t *testing.T) {
+	filter, err := ParseAggregateFilter("14D", "expert", "advanced")
+	if err != nil {
+		t.Fatalf("ParseAggregateFilter() error = %v", err)
+	}
+	if filter.TimePeriod != TimePeriod14Days || filter.Difficulty != "Expert" || filter.Category != "Advanced" {
+		t.Errorf("filter = %+v", filter)
+	}
+
+	if filter, err := ParseAggregateFilter("", "", ""); err != nil || !filter.IsEmpty() {
+		t.Errorf("empty input = %+v, %v; want empty filter", filter, err)
+	}
+	if _, err := ParseAggregateFilter("", "impossible", ""); err == nil {
+		t.Error("unknown difficulty should fail")
+	}
+}
@@ -1,11 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/status"
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
 )
 
 func TestVersionVariables(t *testing.T) {
@@ -26,7 +35,7 @@ func TestRootCommandSetup(t *testing.T) {
 	})
 
 	t.Run("subcommands are registered", func(t *testing.T) {
-		expectedCommands := []string{"play", "statistics", "update", "version"}
+		expectedCommands := []string{"play", "statistics", "config", "export", "import", "migrate", "profile", "quiz", "modes", "serve", "status", "completion", "update", "version", "worksheet", "wrap"}
 		commands := rootCmd.Commands()
 
 		for _, expected := range expectedCommands {
@@ -144,3 +153,363 @@ func TestPlayCommandModeValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionFilterFromFlags(t *testing.T) {
+	modes.RegisterPresets()
+
+	filter, err := sessionFilterFromFlags("2025-01-15", "mixed-basics")
+	if err != nil {
+		t.Fatalf("sessionFilterFromFlags() error = %v", err)
+	}
+	if filter.Mode != "Mixed Basics" {
+		t.Errorf("Mode = %q, want mode name %q", filter.Mode, "Mixed Basics")
+	}
+	if filter.Since.Year() != 2025 || filter.Since.Month() != 1 || filter.Since.Day() != 15 {
+		t.Errorf("Since = %v, want 2025-01-15", filter.Since)
+	}
+
+	if _, err := sessionFilterFromFlags("15/01/2025", ""); err == nil {
+		t.Error("invalid date should fail")
+	}
+	if _, err := sessionFilterFromFlags("", "add"); err == nil {
+		t.Error("unknown mode should fail")
+	}
+}
+
+func TestPlayOverridesFromFlags(t *testing.T) {
+	o, err := playOverridesFromFlags("HARD", "90s", "choice", false)
+	if err != nil {
+		t.Fatalf("playOverridesFromFlags() error = %v", err)
+	}
+	if o.Difficulty == nil || *o.Difficulty != game.Hard {
+		t.Errorf("Difficulty = %v, want Hard", o.Difficulty)
+	}
+	if o.Duration != 90*time.Second {
+		t.Errorf("Duration = %v, want 90s", o.Duration)
+	}
+	if o.InputMethod == nil || *o.InputMethod != components.InputMultipleChoice {
+		t.Errorf("InputMethod = %v, want multiple choice", o.InputMethod)
+	}
+
+	o, err = playOverridesFromFlags("", "", "", false)
+	if err != nil || o.Difficulty != nil || o.Duration != 0 || o.InputMethod != nil {
+		t.Errorf("empty flags = %+v, %v; want no overrides", o, err)
+	}
+
+	// Custom durations only work when skipping the config screen.
+	if _, err := playOverridesFromFlags("", "45s", "", false); err == nil {
+		t.Error("45s without --start should fail")
+	}
+	if o, err := playOverridesFromFlags("", "45s", "", true); err != nil || o.Duration != 45*time.Second {
+		t.Errorf("45s with --start = %v, %v", o.Duration, err)
+	}
+
+	for _, tt := range []struct{ difficulty, duration, input string }{
+		{difficulty: "medum"},
+		{duration: "forever"},
+		{duration: "2s"},
+		{input: "voice"},
+	} {
+		if _, err := playOverridesFromFlags(tt.difficulty, tt.duration, tt.input, true); err == nil {
+			t.Errorf("playOverridesFromFlags(%+v) should fail", tt)
+		}
+	}
+}
+
+func TestStatsSubcommands(t *testing.T) {
+	for _, name := range []string{"summary", "operations", "history", "trends"} {
+		cmd, _, err := statisticsCmd.Find([]string{name})
+		if err != nil || cmd.Name() != name {
+			t.Errorf("statistics %s not registered", name)
+			continue
+		}
+		for _, flag := range []string{"period", "difficulty", "mode", "json"} {
+			if cmd.Flags().Lookup(flag) == nil {
+				t.Errorf("statistics %s missing --%s", name, flag)
+			}
+		}
+		// Categories group operations, so only the views of operations take one
+		hasCategory := name == "summary" || name == "operations"
+		if got := cmd.Flags().Lookup("category") != nil; got != hasCategory {
+			t.Errorf("statistics %s has --category = %v, want %v", name, got, hasCategory)
+		}
+	}
+}
+
+func TestStatsFilterFromFlags(t *testing.T) {
+	modes.RegisterPresets()
+
+	filter, err := statsFilterFromFlags("30d", "hard", "POWER", "squares")
+	if err != nil {
+		t.Fatalf("statsFilterFromFlags() error = %v", err)
+	}
+	if filter.TimePeriod != analytics.TimePeriod30Days {
+		t.Errorf("TimePeriod = %v, want TimePeriod30Days", filter.TimePeriod)
+	}
+	if filter.Difficulty != "Hard" || filter.Category != "Power" || filter.Mode != "Squares" {
+		t.Errorf("filter = %+v, want Hard/Power/Squares", filter)
+	}
+
+	for _, tt := range []struct{ period, difficulty, category, mode string }{
+		{period: "8d"},
+		{difficulty: "medum"},
+		{category: "geometry"},
+		{mode: "add"},
+	} {
+		if _, err := statsFilterFromFlags(tt.period, tt.difficulty, tt.category, tt.mode); err == nil {
+			t.Errorf("statsFilterFromFlags(%+v) should fail", tt)
+		}
+	}
+}
+
+func TestPrintStatsSummaryJSON(t *testing.T) {
+	agg := analytics.ExtendedAggregates{TotalSessions: 3, TotalQuestions: 30, OverallAccuracy: 90}
+	var buf strings.Builder
+	if err := printStatsSummary(&buf, agg, true); err != nil {
+		t.Fatalf("printStatsSummary() error = %v", err)
+	}
+
+	var got statsSummary
+	if err := json.Unmarshal([]byte(buf.String()), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+	}
+	if got.Sessions != 3 || got.Questions != 30 || got.Accuracy != 90 {
+		t.Errorf("summary = %+v", got)
+	}
+}
+
+func TestPrintStatus(t *testing.T) {
+	summary := status.Summary{Today: 2, Streak: 5, LastScore: 1200, Due: 3}
+
+	tests := []struct{ format, want string }{
+		{defaultStatusFormat, "2 today · 5d streak · last 1200 · 3 due\n"},
+		{"{{.Streak}}d\n", "5d\n"},
+	}
+	for _, tt := range tests {
+		tmpl, err := parseStatusFormat(tt.format)
+		if err != nil {
+			t.Fatalf("parseStatusFormat(%q) error = %v", tt.format, err)
+		}
+		var buf strings.Builder
+		if err := printStatus(&buf, tmpl, summary); err != nil {
+			t.Fatalf("printStatus(%q) error = %v", tt.format, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("printStatus(%q) = %q, want %q", tt.format, buf.String(), tt.want)
+		}
+	}
+
+	if _, err := parseStatusFormat("{{.Streak"); err == nil {
+		t.Error("unterminated template should fail to parse")
+	}
+	tmpl, _ := parseStatusFormat("{{.Nope}}")
+	if err := printStatus(&strings.Builder{}, tmpl, summary); err == nil {
+		t.Error("unknown field should fail")
+	}
+}
+
+// complete runs the hidden __complete command and returns the offered values.
+func complete(t *testing.T, args ...string) []string {
+	t.Helper()
+	var out strings.Builder
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(io.Discard)
+	rootCmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+		profileFlag = ""
+	}()
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("__complete %v: %v", args, err)
+	}
+
+	var values []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue // The last line is the directive
+		}
+		value, _, _ := strings.Cut(line, "\t")
+		values = append(values, value)
+	}
+	return values
+}
+
+func TestDynamicCompletions(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+	modes.RegisterPresets()
+
+	if err := storage.CreateProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	record, err := storage.NewSessionRecord("addition", "easy", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.AddSession(record); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"play", "mixed-"}, []string{"mixed-basics", "mixed-powers", "mixed-advanced"}},
+		{[]string{"quiz", "addition", "-d", "e"}, []string{"easy", "expert"}},
+		{[]string{"play", "addition", "--duration", ""}, []string{"30s", "1m", "90s", "2m"}},
+		{[]string{"stats", "summary", "--period", ""}, []string{"all", "7d", "14d", "30d", "90d"}},
+		{[]string{"profile", "use", ""}, []string{"default", "work"}},
+		{[]string{"--profile", "w"}, []string{"work"}},
+		{[]string{"completion", ""}, []string{"bash", "zsh", "fish"}},
+		{[]string{"play", "f"}, []string{"factorials", "flash-anzan"}},
+		{[]string{"quiz", "f"}, []string{"factorials"}},
+		{[]string{"stats", "session", ""}, []string{record.ID}},
+		{[]string{"stats", "session", record.ID, ""}, nil},
+	}
+	for _, tt := range tests {
+		got := complete(t, tt.args...)
+		if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("complete %q = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestPrintStatsSession(t *testing.T) {
+	record, err := storage.NewSessionRecord("addition", "easy", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record.Questions = []storage.QuestionRecord{
+		{Question: "2 + 3", CorrectAnswer: 5, UserAnswer: 5, Correct: true, ResponseTimeMs: 1200, PointsEarned: 10},
+		{Question: "4 + 9", CorrectAnswer: 13, Skipped: true, TimedOut: true, ResponseTimeMs: 5000},
+	}
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{record}}
+
+	found, err := findSession(stats, record.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := findSession(stats, "missing"); err == nil {
+		t.Error("findSession(missing) succeeded")
+	}
+
+	var out strings.Builder
+	if err := printStatsSession(&out, found, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"addition (easy)", "2 + 3", "4 + 9", "timed out"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestDurationFlagValue(t *testing.T) {
+	for _, d := range modes.AllowedDurations {
+		value := durationFlagValue(d.Value)
+		got, err := modes.ParseDuration(value)
+		if err != nil || got != d.Value {
+			t.Errorf("ParseDuration(%q) = %v, %v; want %v", value, got, err, d.Value)
+		}
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	for shell, marker := range map[string]string{
+		"bash": "bash completion V2 for arithmego",
+		"zsh":  "#compdef arithmego",
+		"fish": "fish completion for arithmego",
+	} {
+		var out strings.Builder
+		completionCmd.SetOut(&out)
+		completionCmd.Run(completionCmd, []string{shell})
+		completionCmd.SetOut(nil)
+		if !strings.Contains(out.String(), marker) {
+			t.Errorf("completion %s output lacks %q", shell, marker)
+		}
+	}
+}
+
+func TestModeGroupsTextListsEveryMode(t *testing.T) {
+	modes.RegisterPresets()
+
+	text := modeGroupsText()
+	for _, m := range modes.All() {
+		if n := strings.Count(text, " "+m.ID+",") + strings.Count(text, " "+m.ID+"\n"); n != 1 {
+			t.Errorf("mode %s listed %d times in:\n%s", m.ID, n, text)
+		}
+	}
+
+	cmd := &cobra.Command{Use: "x", Long: "Modes:\n" + modesPlaceholder}
+	expandModeHelp(cmd)
+	if strings.Contains(cmd.Long, modesPlaceholder) || !strings.Contains(cmd.Long, "anything-goes") {
+		t.Errorf("expanded help = %q", cmd.Long)
+	}
+}
+
+func TestPrintModesJSON(t *testing.T) {
+	modes.RegisterPresets()
+	addition, _ := modes.Get(modes.IDAddition)
+	squares, _ := modes.Get(modes.IDSquares)
+
+	var buf strings.Builder
+	best := map[string]int{"Addition": 420}
+	if err := printModes(&buf, []*modes.Mode{addition, squares}, best, true); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []modeInfo
+	if err := json.Unmarshal([]byte(buf.String()), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 || got[0].Group != modes.GroupBasics || got[0].DefaultDurationSeconds != 60 {
+		t.Fatalf("modes = %+v", got)
+	}
+	if got[0].BestScore == nil || *got[0].BestScore != 420 || got[1].BestScore != nil {
+		t.Errorf("best scores = %v, %v; want 420 and none", got[0].BestScore, got[1].BestScore)
+	}
+}
+
+func TestPrintModeShowsSamples(t *testing.T) {
+	modes.RegisterPresets()
+	squares, _ := modes.Get(modes.IDSquares)
+
+	var buf strings.Builder
+	if err := printMode(&buf, squares, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Squares (squares)", "Generator", "Best score          -", "Beginner", "Expert", "²"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output lacks %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestResetAllSettingsKeepsBackend(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	config := storage.NewConfig()
+	config.Onboarded = true
+	config.Theme = "light"
+	config.StorageBackend = storage.BackendSQLite
+	if err := storage.SaveConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := resetAllSettings(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := storage.LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Onboarded || got.Theme != "" {
+		t.Errorf("settings not reset: onboarded %v, theme %q", got.Onboarded, got.Theme)
+	}
+	if got.StorageBackend != storage.BackendSQLite {
+		t.Errorf("StorageBackend = %q, want %q", got.StorageBackend, storage.BackendSQLite)
+	}
+}
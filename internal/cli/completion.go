@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// maxSessionCompletions limits how many recent session IDs are offered.
+const maxSessionCompletions = 50
+
+var completionCmd = &cobra.Command{
+	Use:   "completion bash|zsh|fish",
+	Short: "Generate a shell completion script",
+	Long: `Generate a tab completion script for your shell.
+
+Completions cover commands and flags as well as mode IDs, difficulties,
+durations, profile names, settings, export files and session IDs (for
+'stats session'). There are no deck files, so no deck completion.
+
+Bash (needs the bash-completion package):
+  source <(arithmego completion bash)
+  arithmego completion bash > /etc/bash_completion.d/arithmego
+
+Zsh:
+  arithmego completion zsh > "${fpath[1]}/_arithmego"
+  (run 'autoload -U compinit; compinit' once if completion is not enabled)
+
+Fish:
+  arithmego completion fish > ~/.config/fish/completions/arithmego.fish`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"bash", "zsh", "fish"},
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		out := cmd.OutOrStdout()
+		switch args[0] {
+		case "bash":
+			err = rootCmd.GenBashCompletionV2(out, true)
+		case "zsh":
+			err = rootCmd.GenZshCompletion(out)
+		case "fish":
+			err = rootCmd.GenFishCompletion(out, true)
+		default:
+			err = fmt.Errorf("unsupported shell %q (valid: bash, zsh, fish)", args[0])
+		}
+		exitOnError(err)
+	},
+}
+
+// completeModeArg completes a mode ID as the first positional argument.
+func completeModeArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeModeIDs(cmd, args, toComplete)
+}
+
+// completeHeadlessModeArg is completeModeArg without the flashed modes,
+// which only the game can play.
+func completeHeadlessModeArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var completions []string
+	for _, mode := range modes.All() {
+		if !mode.Flashed() && strings.HasPrefix(mode.ID, toComplete) {
+			completions = append(completions, mode.ID+"\t"+mode.Name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeModeIDs completes mode IDs, described by their names.
+func completeModeIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, mode := range modes.All() {
+		if strings.HasPrefix(mode.ID, toComplete) {
+			completions = append(completions, mode.ID+"\t"+mode.Name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeDifficulties completes difficulty names in lower case.
+func completeDifficulties(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, d := range game.AllDifficulties() {
+		name := strings.ToLower(d.String())
+		if strings.HasPrefix(name, strings.ToLower(toComplete)) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeDurations completes the selectable session durations.
+// Other durations can still be typed.
+func completeDurations(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, d := range modes.AllowedDurations {
+		value := durationFlagValue(d.Value)
+		if strings.HasPrefix(value, toComplete) {
+			completions = append(completions, value+"\t"+d.Label)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// durationFlagValue formats d the way --duration accepts it, e.g. "90s" or "2m".
+func durationFlagValue(d time.Duration) string {
+	if d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
+}
+
+// completeProfiles completes profile names.
+func completeProfiles(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, err := storage.ListProfiles()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var completions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeProfileArg completes a profile name as the first positional argument.
+func completeProfileArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeProfiles(cmd, args, toComplete)
+}
+
+// completeSessionArg completes the ID of one of the most recent sessions as
+// the first positional argument, described by its date, mode and score. The
+// --profile flag, if already typed, selects whose sessions are offered.
+func completeSessionArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if err := selectProfile(profileFlag); err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	stats, err := queryStatistics(analytics.AggregateFilter{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var completions []string
+	for i := len(stats.Sessions) - 1; i >= 0 && len(completions) < maxSessionCompletions; i-- {
+		s := stats.Sessions[i]
+		if strings.HasPrefix(s.ID, toComplete) {
+			completions = append(completions, fmt.Sprintf("%s\t%s %s, score %d",
+				s.ID, s.Timestamp.Local().Format(statsDateLayout), s.Mode, s.Score))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+}
+
+// completeStatsFilters registers completions for the stats filter flags.
+func completeStatsFilters(cmd *cobra.Command) {
+	var periods []string
+	for _, p := range analytics.AllTimePeriods() {
+		periods = append(periods, p.Key()+"\t"+p.String())
+	}
+	var categories []string
+	for _, c := range analytics.AllCategories() {
+		if c != "" {
+			categories = append(categories, strings.ToLower(c))
+		}
+	}
+
+	_ = cmd.RegisterFlagCompletionFunc("period", cobra.FixedCompletions(periods, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("difficulty", completeDifficulties)
+	_ = cmd.RegisterFlagCompletionFunc("category", cobra.FixedCompletions(categories, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("mode", completeModeIDs)
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/settings"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+var (
+	configListJSON bool
+	configResetYes bool
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and change settings",
+	Long: `Read and change the settings stored in config.json for the active profile.
+
+Values are checked the same way as on the settings screen. Durations accept
+milliseconds or Go durations such as 90s, and booleans accept true/false.
+
+Examples:
+  arithmego config list
+  arithmego config get default_difficulty
+  arithmego config set default_duration_ms 90s
+  arithmego config reset input_method
+  arithmego config path`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		printConfig(loadConfigOrExit(), false)
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all settings and their values",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		printConfig(loadConfigOrExit(), configListJSON)
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:               "get <key>",
+	Short:             "Print the value of a setting",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeConfigArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		value, err := settings.Get(loadConfigOrExit(), args[0])
+		exitOnError(err)
+		fmt.Println(value)
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:               "set <key> <value>",
+	Short:             "Change a setting",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeConfigArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := loadConfigOrExit()
+		exitOnError(settings.Set(config, args[0], args[1]))
+		exitOnError(storage.SaveConfig(config))
+
+		value, _ := settings.Get(config, args[0])
+		fmt.Printf("%s = %s\n", args[0], value)
+		if args[0] == settings.KeyStorageBackend {
+			fmt.Println("Existing history was not moved. Use 'arithmego migrate' to copy it to another backend.")
+		}
+	},
+}
+
+var configResetCmd = &cobra.Command{
+	Use:   "reset [key]",
+	Short: "Restore a setting, or all settings, to the default",
+	Long: `Restore one setting to its default value. Without a key, every setting is
+reset, including onboarding, so the first-run setup is shown again. The
+storage backend is kept, so your history stays where it is.`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeConfigArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			if !configResetYes && !confirm("Reset all settings to their defaults? [y/N] ") {
+				fmt.Println("Cancelled.")
+				return
+			}
+			exitOnError(resetAllSettings())
+			fmt.Println("All settings reset.")
+			return
+		}
+
+		config := loadConfigOrExit()
+		exitOnError(settings.Reset(config, args[0]))
+		exitOnError(storage.SaveConfig(config))
+
+		value, _ := settings.Get(config, args[0])
+		fmt.Printf("%s = %s\n", args[0], value)
+		if args[0] == settings.KeyStorageBackend {
+			fmt.Println("Existing history was not moved. Use 'arithmego migrate' to copy it to another backend.")
+		}
+	},
+}
+
+// resetAllSettings saves a default config for the active profile. The
+// storage backend is kept: switching it without 'arithmego migrate' would
+// hide the recorded history. A config that cannot be read is replaced
+// outright.
+func resetAllSettings() error {
+	fresh := storage.NewConfig()
+	if config, err := storage.LoadConfig(); err == nil {
+		fresh.StorageBackend = config.StorageBackend
+	}
+	return storage.SaveConfig(fresh)
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the location of config.json",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := storage.ConfigPath()
+		exitOnError(err)
+		fmt.Println(path)
+	},
+}
+
+// loadConfigOrExit loads the active profile's config. Exits on error.
+func loadConfigOrExit() *storage.Config {
+	config, err := storage.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	return config
+}
+
+// configEntry is the JSON form of one setting in 'config list --json'.
+type configEntry struct {
+	Key         string   `json:"key"`
+	Value       string   `json:"value"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Values      []string `json:"values,omitempty"`
+}
+
+func printConfig(config *storage.Config, asJSON bool) {
+	fields := settings.Fields()
+	if asJSON {
+		entries := make([]configEntry, len(fields))
+		for i, f := range fields {
+			entries[i] = configEntry{
+				Key:         f.Key,
+				Value:       f.Get(config),
+				Type:        f.Type,
+				Description: f.Description,
+				Values:      f.Values,
+			}
+		}
+		exitOnError(writeJSON(os.Stdout, entries))
+		return
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tDESCRIPTION")
+	for _, f := range fields {
+		value := f.Get(config)
+		if value == "" {
+			value = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", f.Key, value, f.Description)
+	}
+	exitOnError(tw.Flush())
+}
+
+// completeConfigArgs completes config keys, then allowed values for set.
+func completeConfigArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	switch {
+	case len(args) == 0:
+		for _, f := range settings.Fields() {
+			if strings.HasPrefix(f.Key, toComplete) {
+				completions = append(completions, f.Key+"\t"+f.Description)
+			}
+		}
+	case len(args) == 1 && cmd.Name() == "set":
+		if f, err := settings.Lookup(args[0]); err == nil {
+			for _, v := range f.Values {
+				if strings.HasPrefix(v, toComplete) {
+					completions = append(completions, v)
+				}
+			}
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	configListCmd.Flags().BoolVar(&configListJSON, "json", false, "output as JSON")
+	configResetCmd.Flags().BoolVarP(&configResetYes, "yes", "y", false, "reset all settings without asking for confirmation")
+
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configResetCmd)
+	configCmd.AddCommand(configPathCmd)
+	rootCmd.AddCommand(configCmd)
+}
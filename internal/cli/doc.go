@@ -4,15 +4,31 @@
 //
 //   - arithmego: Opens the main menu (default behavior)
 //   - arithmego play [mode]: Opens play browse, or config for a specific mode
+//   - arithmego modes list|show <id>: Lists modes or shows one with sample questions
+//   - arithmego quiz <mode>: Plays in plain text (or JSON lines) over stdin/stdout
+//   - arithmego worksheet <mode>: Prints a worksheet with an optional answer key
+//   - arithmego serve: Serves sessions and statistics over a local HTTP/JSON API
 //   - arithmego statistics: Opens the statistics screen directly
+//   - arithmego stats summary|operations|history|trends: Prints statistics as tables or JSON
+//   - arithmego status: Prints a one-line summary for shell prompts and tmux
+//   - arithmego config: Lists, reads, changes and resets settings
+//   - arithmego export: Writes sessions to CSV, JSON or JSONL
+//   - arithmego import <file>: Merges sessions from another machine
+//   - arithmego migrate: Moves statistics between the JSON and SQLite backends
+//   - arithmego profile: Lists, creates, renames, deletes and switches profiles
+//   - arithmego wrap -- <cmd>: Runs a command in the background while you play
+//   - arithmego completion bash|zsh|fish: Prints a shell completion script
 //   - arithmego update: Checks for available updates
 //   - arithmego version: Displays version and build information
 //
 // The play command accepts an optional mode argument to jump directly to
-// the configuration screen for that mode. Valid modes include: addition,
-// subtraction, multiplication, division, squares, cubes, square-roots,
-// cube-roots, exponents, remainders, percentages, factorials, mixed-basics,
-// mixed-powers, mixed-advanced, and anything-goes.
+// the configuration screen for that mode. The --difficulty, --duration and
+// --input flags preselect settings, and --start skips the configuration
+// screen to begin the game immediately. Its help and unknown-mode error list
+// the modes from the registry; 'arithmego modes list' shows them in detail.
+//
+// The persistent --profile flag runs any command as the named profile. Without
+// it, the profile last chosen with 'profile use' or the menu switcher is used.
 //
 // Version is injected via ldflags during the build process. See the Makefile.
 package cli
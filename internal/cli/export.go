@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/export"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// sinceLayout is the date format accepted by --since.
+const sinceLayout = "2006-01-02"
+
+var (
+	exportFormat string
+	exportRows   string
+	exportSince  string
+	exportMode   string
+	exportOut    string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export statistics to CSV or JSON",
+	Long: `Export recorded sessions for use in spreadsheets and notebooks.
+
+Formats:
+  csv     One row per question (or per session with --rows sessions)
+  json    A single document with a "sessions" array
+  jsonl   One session per line
+
+Questions are written in the notation each session was played in; the
+notation setting does not change what was recorded.
+
+Examples:
+  arithmego export > history.csv
+  arithmego export --format json --out history.json
+  arithmego export --format jsonl --since 2025-01-01 --mode addition`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := exportOptionsFromFlags()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		stats, err := queryStatistics(opts.Filter)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading statistics: %v\n", err)
+			fmt.Fprintln(os.Stderr, "Run 'arithmego statistics' to repair a damaged statistics file.")
+			os.Exit(1)
+		}
+
+		// Open the output only once there is something to write, so a
+		// failed load leaves an earlier export in place.
+		var w io.Writer = os.Stdout
+		if exportOut != "" {
+			f, err := os.OpenFile(exportOut, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		n, err := export.Write(w, stats, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		if exportOut != "" {
+			fmt.Fprintf(os.Stderr, "Exported %d sessions to %s\n", n, exportOut)
+		}
+	},
+}
+
+// queryStatistics loads the sessions matching filter from the configured
+// storage backend. Session-level filters are applied by the backend.
+func queryStatistics(filter analytics.AggregateFilter) (*storage.Statistics, error) {
+	backend, err := storage.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer backend.Close()
+
+	return backend.Query(filter.SessionQuery())
+}
+
+// exportOptionsFromFlags validates the export flags and builds export options.
+func exportOptionsFromFlags() (export.Options, error) {
+	format, err := export.ParseFormat(exportFormat)
+	if err != nil {
+		return export.Options{}, err
+	}
+
+	rows, err := export.ParseRows(exportRows)
+	if err != nil {
+		return export.Options{}, err
+	}
+
+	filter, err := sessionFilterFromFlags(exportSince, exportMode)
+	if err != nil {
+		return export.Options{}, err
+	}
+
+	return export.Options{Format: format, Rows: rows, Filter: filter}, nil
+}
+
+// sessionFilterFromFlags builds an aggregate filter from --since and --mode values.
+// Mode is given as a mode ID and mapped to the name stored in session records.
+func sessionFilterFromFlags(since, modeID string) (analytics.AggregateFilter, error) {
+	var filter analytics.AggregateFilter
+
+	if since != "" {
+		t, err := time.ParseInLocation(sinceLayout, since, time.Local)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --since date %q (want YYYY-MM-DD)", since)
+		}
+		filter.Since = t
+	}
+
+	if modeID != "" {
+		mode, ok := modes.Get(modeID)
+		if !ok {
+			return filter, fmt.Errorf("unknown mode %q", modeID)
+		}
+		filter.Mode = mode.Name
+	}
+
+	return filter, nil
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", string(export.FormatCSV), "output format: csv, json or jsonl")
+	exportCmd.Flags().StringVar(&exportRows, "rows", string(export.RowsQuestions), "CSV rows: questions or sessions")
+	exportCmd.Flags().StringVar(&exportSince, "since", "", "only sessions on or after this date (YYYY-MM-DD)")
+	exportCmd.Flags().StringVar(&exportMode, "mode", "", "only sessions of this mode ID")
+	exportCmd.Flags().StringVarP(&exportOut, "out", "o", "", "write to file instead of stdout")
+
+	var formats []string
+	for _, f := range export.AllFormats() {
+		formats = append(formats, string(f))
+	}
+	_ = exportCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(formats, cobra.ShellCompDirectiveNoFileComp))
+	_ = exportCmd.RegisterFlagCompletionFunc("rows", cobra.FixedCompletions([]string{string(export.RowsQuestions), string(export.RowsSessions)}, cobra.ShellCompDirectiveNoFileComp))
+	_ = exportCmd.RegisterFlagCompletionFunc("mode", completeModeIDs)
+	_ = exportCmd.RegisterFlagCompletionFunc("out", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return formats, cobra.ShellCompDirectiveFilterFileExt
+	})
+	rootCmd.AddCommand(exportCmd)
+}
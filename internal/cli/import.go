@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/export"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+var importDryRun bool
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Merge history from another machine",
+	Long: `Merge sessions from another statistics.json, or from a JSON/JSONL export,
+into your local history.
+
+Sessions are matched by ID: sessions you already have are skipped, and sessions
+whose ID exists locally with different data are reported as conflicts (your
+local copy is kept). Invalid records are reported and skipped.
+
+Examples:
+  arithmego import laptop-statistics.json
+  arithmego import --dry-run history.jsonl`,
+	Args: cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return []string{"json", "jsonl"}, cobra.ShellCompDirectiveFilterFileExt
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		path := args[0]
+
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		incoming, err := export.ReadSessions(f)
+		_ = f.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", path, err)
+			os.Exit(1)
+		}
+
+		backend, err := storage.Open()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		defer backend.Close()
+
+		stats, err := backend.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading statistics: %v\n", err)
+			fmt.Fprintln(os.Stderr, "Run 'arithmego statistics' to repair a damaged statistics file.")
+			os.Exit(1)
+		}
+
+		result := storage.Merge(stats, incoming)
+		printMergeResult(os.Stdout, path, len(incoming), result)
+
+		if importDryRun {
+			fmt.Println()
+			fmt.Println("Dry run: no changes written.")
+			return
+		}
+
+		if len(result.Added) == 0 {
+			return
+		}
+
+		if err := backend.Save(stats); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving statistics: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// printMergeResult writes a human-readable summary of an import.
+func printMergeResult(w io.Writer, path string, total int, result storage.MergeResult) {
+	fmt.Fprintf(w, "Read %d sessions from %s\n\n", total, path)
+	fmt.Fprintf(w, "  Added       %4d\n", len(result.Added))
+	fmt.Fprintf(w, "  Duplicates  %4d\n", result.Duplicates)
+	fmt.Fprintf(w, "  Conflicts   %4d\n", len(result.Conflicts))
+	fmt.Fprintf(w, "  Invalid     %4d\n", len(result.Invalid))
+
+	if len(result.Conflicts) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Conflicts (local copy kept):")
+		for _, c := range result.Conflicts {
+			fmt.Fprintf(w, "  %s  %s (%s)  %s\n",
+				c.Local.ID, c.Local.Mode, c.Local.Difficulty, c.String())
+		}
+	}
+
+	if len(result.Invalid) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Invalid records (skipped):")
+		for _, inv := range result.Invalid {
+			id := inv.ID
+			if id == "" {
+				id = "(no id)"
+			}
+			fmt.Fprintf(w, "  #%d %s: %v\n", inv.Index, id, inv.Err)
+		}
+	}
+}
+
+func init() {
+	importCmd.Flags().BoolVarP(&importDryRun, "dry-run", "n", false, "show what would be imported without saving")
+	rootCmd.AddCommand(importCmd)
+}
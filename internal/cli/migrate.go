@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+var migrateTo string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move statistics to another storage backend",
+	Long: `Copy all sessions to another storage backend and switch to it.
+
+The default backend stores history in statistics.json. The sqlite backend
+stores it in statistics.db, which keeps filtered statistics fast for long
+histories. The old file is left in place, so you can switch back at any time.
+
+Examples:
+  arithmego migrate              # JSON to SQLite
+  arithmego migrate --to json    # back to JSON`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := storage.ValidateBackend(migrateTo); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		config, err := storage.LoadConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		current := config.StorageBackend
+		if current == "" {
+			current = storage.DefaultBackend
+		}
+		if current == migrateTo {
+			fmt.Printf("Already using the %s backend.\n", current)
+			return
+		}
+
+		n, err := migrateBackend(current, migrateTo)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		config.StorageBackend = migrateTo
+		if err := storage.SaveConfig(config); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Migrated %d sessions from %s to %s.\n", n, current, migrateTo)
+	},
+}
+
+// migrateBackend copies all sessions between two backends.
+func migrateBackend(fromName, toName string) (int, error) {
+	from, err := storage.OpenBackend(fromName)
+	if err != nil {
+		return 0, err
+	}
+	defer from.Close()
+
+	to, err := storage.OpenBackend(toName)
+	if err != nil {
+		return 0, err
+	}
+	defer to.Close()
+
+	return storage.Migrate(from, to)
+}
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateTo, "to", storage.BackendSQLite, "target backend (json, sqlite)")
+	_ = migrateCmd.RegisterFlagCompletionFunc("to", cobra.FixedCompletions(storage.AllBackends(), cobra.ShellCompDirectiveNoFileComp))
+	rootCmd.AddCommand(migrateCmd)
+}
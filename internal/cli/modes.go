@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// modeSamples is how many sample questions 'modes show' prints per difficulty.
+const modeSamples = 3
+
+var modesListJSON bool
+
+var modesCmd = &cobra.Command{
+	Use:   "modes",
+	Short: "List and inspect game modes",
+	Long: `List the game modes, or show the details of one mode.
+
+Examples:
+  arithmego modes
+  arithmego modes list --json
+  arithmego modes show mixed-powers`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		exitOnError(printModes(os.Stdout, modes.All(), loadBestScores(), false))
+	},
+}
+
+var modesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all game modes",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		exitOnError(printModes(os.Stdout, modes.All(), loadBestScores(), modesListJSON))
+	},
+}
+
+var modesShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show a mode with sample questions",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mode, ok := modes.Get(args[0])
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", unknownModeMessage(args[0]))
+			os.Exit(1)
+		}
+		exitOnError(printMode(os.Stdout, mode, loadBestScores()))
+	},
+	ValidArgsFunction: completeModeArg,
+}
+
+// modeInfo is the JSON form of a mode.
+type modeInfo struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	Group                  string `json:"group"`
+	Category               string `json:"category"`
+	Generator              string `json:"generator"`
+	DefaultDifficulty      string `json:"default_difficulty"`
+	DefaultDurationSeconds int    `json:"default_duration_seconds"`
+	BestScore              *int   `json:"best_score"` // null if never played
+}
+
+func newModeInfo(m *modes.Mode, best map[string]int) modeInfo {
+	info := modeInfo{
+		ID:                     m.ID,
+		Name:                   m.Name,
+		Description:            m.Description,
+		Group:                  m.Group,
+		Category:               m.Category.String(),
+		Generator:              m.GeneratorLabel,
+		DefaultDifficulty:      m.DefaultDifficulty.String(),
+		DefaultDurationSeconds: int(m.DefaultDuration.Seconds()),
+	}
+	if score, ok := best[m.Name]; ok {
+		info.BestScore = &score
+	}
+	return info
+}
+
+// loadBestScores returns the best score per mode name. Statistics are only
+// extra information here, so a read error is reported and listing goes on.
+func loadBestScores() map[string]int {
+	stats, err := queryStatistics(analytics.AggregateFilter{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: best scores unavailable: %v\n", err)
+		return nil
+	}
+	// Question timer runs score differently, so only the kind the player
+	// has set up counts
+	config, _ := storage.LoadConfig()
+	return analytics.BestScoresByMode(stats, config != nil && config.QuestionTimer)
+}
+
+// printModes writes the modes as a table or as JSON.
+func printModes(w io.Writer, all []*modes.Mode, best map[string]int, asJSON bool) error {
+	infos := make([]modeInfo, 0, len(all))
+	for _, m := range all {
+		infos = append(infos, newModeInfo(m, best))
+	}
+	if asJSON {
+		return writeJSON(w, infos)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tNAME\tGROUP\tCATEGORY\tDIFFICULTY\tDURATION\tBEST")
+	for i, m := range infos {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", m.ID, m.Name, m.Group, m.Category,
+			m.DefaultDifficulty, durationFlagValue(all[i].DefaultDuration), bestScoreText(m.BestScore))
+	}
+	return tw.Flush()
+}
+
+// printMode writes the details of one mode with sample questions.
+func printMode(w io.Writer, m *modes.Mode, best map[string]int) error {
+	info := newModeInfo(m, best)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s (%s)\n", m.Name, m.ID)
+	fmt.Fprintf(tw, "%s\n\n", m.Description)
+	fmt.Fprintf(tw, "Group\t%s\n", info.Group)
+	fmt.Fprintf(tw, "Category\t%s\n", info.Category)
+	fmt.Fprintf(tw, "Generator\t%s\n", info.Generator)
+	fmt.Fprintf(tw, "Default difficulty\t%s\n", info.DefaultDifficulty)
+	fmt.Fprintf(tw, "Default duration\t%s\n", durationFlagValue(m.DefaultDuration))
+	fmt.Fprintf(tw, "Best score\t%s\n", bestScoreText(info.BestScore))
+
+	g, ok := gen.Get(m.GeneratorLabel)
+	if ok {
+		fmt.Fprintln(tw, "\nSample questions:")
+		for _, d := range game.AllDifficulties() {
+			fmt.Fprintf(tw, "  %s\t%s\n", d.String(), strings.Join(sampleQuestions(g, d, modeSamples), "   "))
+		}
+	}
+	return tw.Flush()
+}
+
+// sampleQuestions returns up to n different questions at a difficulty.
+func sampleQuestions(g game.Generator, d game.Difficulty, n int) []string {
+	pool := game.NewQuestionPool(g, d)
+	samples := make([]string, 0, n)
+	for len(samples) < n {
+		q := pool.Next()
+		if q == nil {
+			break
+		}
+		samples = append(samples, q.Display)
+	}
+	return samples
+}
+
+func bestScoreText(score *int) string {
+	if score == nil {
+		return "-"
+	}
+	return fmt.Sprint(*score)
+}
+
+// modeGroupsText lists mode IDs by group, one group per line, for help and
+// error output.
+func modeGroupsText() string {
+	width := 0
+	for _, group := range modes.GroupOrder {
+		width = max(width, len(group))
+	}
+
+	var b strings.Builder
+	for _, group := range modes.GroupOrder {
+		var ids []string
+		for _, m := range modes.ByGroup(group) {
+			ids = append(ids, m.ID)
+		}
+		if len(ids) > 0 {
+			fmt.Fprintf(&b, "  %-*s %s\n", width+1, group+":", strings.Join(ids, ", "))
+		}
+	}
+	return b.String()
+}
+
+// modesPlaceholder in a command's Long help is replaced with
+// [modeGroupsText] once the modes are registered.
+const modesPlaceholder = "{{modes}}"
+
+// expandModeHelp fills in the mode list in the help of all commands.
+func expandModeHelp(cmd *cobra.Command) {
+	cmd.Long = strings.ReplaceAll(cmd.Long, modesPlaceholder, modeGroupsText())
+	for _, sub := range cmd.Commands() {
+		expandModeHelp(sub)
+	}
+}
+
+// unknownModeMessage explains that id is not a mode and lists the valid ones.
+func unknownModeMessage(id string) string {
+	return fmt.Sprintf("unknown mode %q\n\nAvailable modes:\n%s\n\nRun 'arithmego modes list' for details.", id, strings.TrimRight(modeGroupsText(), "\n"))
+}
+
+// flashedModeMessage explains why a headless command cannot use mode.
+func flashedModeMessage(mode *modes.Mode) string {
+	return fmt.Sprintf("%s flashes its numbers one at a time, so it can only be played in the game ('arithmego play %s')", mode.Name, mode.ID)
+}
+
+func init() {
+	modesListCmd.Flags().BoolVar(&modesListJSON, "json", false, "output as JSON")
+
+	modesCmd.AddCommand(modesListCmd)
+	modesCmd.AddCommand(modesShowCmd)
+	rootCmd.AddCommand(modesCmd)
+}
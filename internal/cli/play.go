@@ -7,8 +7,18 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/modes"
 	"github.com/gurselcakar/arithmego/internal/ui"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/screens"
+)
+
+var (
+	playDifficulty string
+	playDuration   string
+	playInput      string
+	playStart      bool
 )
 
 var playCmd = &cobra.Command{
@@ -17,20 +27,31 @@ var playCmd = &cobra.Command{
 	Long: `Open the play screen to browse and select a game mode.
 
 If a mode is specified, opens the configuration screen for that mode directly.
+Flags preselect settings on that screen; with --start the game begins right
+away, using saved settings for anything not given. Without --start, the
+duration must be one of 30s, 1m, 90s or 2m; with --start any duration from
+10s to 1h works.
 
 Available modes:
-  Basic:    addition, subtraction, multiplication, division
-  Powers:   squares, cubes, square-roots, cube-roots
-  Advanced: exponents, remainders, percentages, factorials
-  Mixed:    mixed-basics, mixed-powers, mixed-advanced, anything-goes
-
+{{modes}}
 Examples:
   arithmego play              # Browse all modes
   arithmego play addition     # Configure Addition mode
-  arithmego play mixed-basics # Configure Mixed Basics mode`,
+  arithmego play mixed-basics # Configure Mixed Basics mode
+  arithmego play addition --difficulty hard --duration 45s --input choice --start`,
 	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		overrides, err := playOverridesFromFlags(playDifficulty, playDuration, playInput, playStart)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
 		if len(args) == 0 {
+			if hasPlayFlags(cmd) {
+				fmt.Fprintln(os.Stderr, "Error: --difficulty, --duration, --input and --start need a mode, e.g. 'arithmego play addition --start'")
+				os.Exit(1)
+			}
 			// No mode specified - open play browse
 			runTUI(ui.StartModePlayBrowse)
 			return
@@ -40,33 +61,82 @@ Examples:
 		modeID := args[0]
 		mode, ok := modes.Get(modeID)
 		if !ok {
-			fmt.Fprintf(os.Stderr, "Unknown mode: %s\n\n", modeID)
-			fmt.Fprintln(os.Stderr, "Available modes:")
-			fmt.Fprintln(os.Stderr, "  Basic:    addition, subtraction, multiplication, division")
-			fmt.Fprintln(os.Stderr, "  Powers:   squares, cubes, square-roots, cube-roots")
-			fmt.Fprintln(os.Stderr, "  Advanced: exponents, remainders, percentages, factorials")
-			fmt.Fprintln(os.Stderr, "  Mixed:    mixed-basics, mixed-powers, mixed-advanced, anything-goes")
+			fmt.Fprintf(os.Stderr, "Error: %s\n", unknownModeMessage(modeID))
 			os.Exit(1)
 		}
 
 		ui.CLIModeID = mode.ID
+		ui.CLIPlayOverrides = overrides
+		if playStart {
+			runTUI(ui.StartModeGame)
+			return
+		}
 		runTUI(ui.StartModePlayConfig)
 	},
-	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		if len(args) != 0 {
-			return nil, cobra.ShellCompDirectiveNoFileComp
+	ValidArgsFunction: completeModeArg,
+}
+
+// hasPlayFlags reports whether any settings flag was given.
+func hasPlayFlags(cmd *cobra.Command) bool {
+	for _, name := range []string{"difficulty", "duration", "input", "start"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// playOverridesFromFlags validates the play flags. Empty values keep the
+// saved settings. Durations outside modes.AllowedDurations need start,
+// since the config screen can only show the allowed ones.
+func playOverridesFromFlags(difficulty, duration, input string, start bool) (screens.PlayOverrides, error) {
+	var o screens.PlayOverrides
+
+	if difficulty != "" {
+		d, err := game.ParseDifficulty(difficulty)
+		if err != nil {
+			return o, err
 		}
-		// Return all mode IDs for tab completion
-		var completions []string
-		for _, mode := range modes.All() {
-			if strings.HasPrefix(mode.ID, toComplete) {
-				completions = append(completions, mode.ID+"\t"+mode.Name)
+		o.Difficulty = &d
+	}
+
+	if duration != "" {
+		d, err := modes.ParseDuration(duration)
+		if err != nil {
+			return o, err
+		}
+		if !start && !modes.IsAllowedDuration(d) {
+			var labels []string
+			for _, dur := range modes.AllowedDurations {
+				labels = append(labels, dur.Label)
 			}
+			return o, fmt.Errorf("duration %s is not on the config screen (%s); add --start to play it", d, strings.Join(labels, ", "))
 		}
-		return completions, cobra.ShellCompDirectiveNoFileComp
-	},
+		o.Duration = d
+	}
+
+	switch strings.ToLower(input) {
+	case "":
+	case "typing":
+		m := components.InputTyping
+		o.InputMethod = &m
+	case "choice":
+		m := components.InputMultipleChoice
+		o.InputMethod = &m
+	default:
+		return o, fmt.Errorf("unknown input method %q (valid: typing, choice)", input)
+	}
+
+	return o, nil
 }
 
 func init() {
+	playCmd.Flags().StringVarP(&playDifficulty, "difficulty", "d", "", "difficulty (beginner, easy, medium, hard, expert)")
+	playCmd.Flags().StringVar(&playDuration, "duration", "", "session duration, e.g. 45s or 2m")
+	playCmd.Flags().StringVar(&playInput, "input", "", "input method (typing, choice)")
+	playCmd.Flags().BoolVar(&playStart, "start", false, "skip the config screen and start the game")
+	_ = playCmd.RegisterFlagCompletionFunc("difficulty", completeDifficulties)
+	_ = playCmd.RegisterFlagCompletionFunc("duration", completeDurations)
+	_ = playCmd.RegisterFlagCompletionFunc("input", cobra.FixedCompletions([]string{"typing", "choice"}, cobra.ShellCompDirectiveNoFileComp))
 	rootCmd.AddCommand(playCmd)
 }
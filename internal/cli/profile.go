@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+var profileDeleteYes bool
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage player profiles",
+	Long: `Manage named player profiles. Each profile has its own settings and history.
+
+Play as a profile for one run with --profile, or make it the default with
+'arithmego profile use'. The profile can also be switched from the main menu.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		printProfiles()
+	},
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List profiles",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		printProfiles()
+	},
+}
+
+var profileCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new profile",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := storage.CreateProfile(args[0]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Created profile %s. Play with: arithmego --profile %s\n", args[0], args[0])
+	},
+}
+
+var profileRenameCmd = &cobra.Command{
+	Use:               "rename <old> <new>",
+	Short:             "Rename a profile",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeProfileArg,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := storage.RenameProfile(args[0], args[1]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Renamed profile %s to %s.\n", args[0], args[1])
+	},
+}
+
+var profileDeleteCmd = &cobra.Command{
+	Use:               "delete <name>",
+	Short:             "Delete a profile and its history",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeProfileArg,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if err := storage.CanDeleteProfile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		if !profileDeleteYes && !confirm(fmt.Sprintf("Delete profile %s and all of its statistics? [y/N] ", name)) {
+			fmt.Println("Cancelled.")
+			return
+		}
+
+		if err := storage.DeleteProfile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Deleted profile %s.\n", name)
+	},
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:               "use <name>",
+	Short:             "Set the profile used when --profile is not given",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeProfileArg,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if err := storage.SetActiveProfile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := storage.SaveSelectedProfile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Now using profile %s.\n", name)
+	},
+}
+
+// printProfiles lists all profiles, marking the active one.
+func printProfiles() {
+	names, err := storage.ListProfiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	active := storage.ActiveProfile()
+	for _, name := range names {
+		marker := "  "
+		if name == active {
+			marker = "* "
+		}
+		fmt.Println(marker + name)
+	}
+}
+
+// confirm asks a yes/no question on stdin. Anything but "y" or "yes" is no.
+func confirm(prompt string) bool {
+	fmt.Print(prompt)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func init() {
+	profileDeleteCmd.Flags().BoolVarP(&profileDeleteYes, "yes", "y", false, "delete without asking for confirmation")
+
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileCreateCmd)
+	profileCmd.AddCommand(profileRenameCmd)
+	profileCmd.AddCommand(profileDeleteCmd)
+	profileCmd.AddCommand(profileUseCmd)
+	rootCmd.AddCommand(profileCmd)
+}
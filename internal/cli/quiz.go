@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/history"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/quiz"
+)
+
+var (
+	quizDifficulty string
+	quizCount      int
+	quizJSON       bool
+	quizNoSave     bool
+)
+
+var quizCmd = &cobra.Command{
+	Use:   "quiz <mode>",
+	Short: "Play in plain text over stdin/stdout",
+	Long: `Play a session without the full-screen interface.
+
+Questions are printed one per line and answers are read one per line from
+stdin, so quiz works over dumb terminals, in CI logs and in pipes. Enter a
+number to answer, 's' to skip or 'q' to stop. The quiz also stops cleanly at
+end of input. Sessions are scored like the TUI and saved to statistics.
+
+With --json, every output line is a JSON event (start, question, result,
+error, summary) and input lines may be JSON objects such as {"answer": 12},
+{"skip": true} or {"quit": true}.
+
+Examples:
+  arithmego quiz addition
+  arithmego quiz multiplication --difficulty hard --count 20
+  my-solver | arithmego quiz division --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mode, ok := modes.Get(args[0])
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", unknownModeMessage(args[0]))
+			os.Exit(1)
+		}
+		if mode.Flashed() {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", flashedModeMessage(mode))
+			os.Exit(1)
+		}
+
+		diff := mode.DefaultDifficulty
+		if quizDifficulty != "" {
+			var err error
+			diff, err = game.ParseDifficulty(quizDifficulty)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		if quizCount < 0 {
+			fmt.Fprintln(os.Stderr, "Error: --count cannot be negative")
+			os.Exit(1)
+		}
+
+		g, ok := gen.Get(mode.GeneratorLabel)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: no generator for mode %q\n", mode.ID)
+			os.Exit(1)
+		}
+
+		opts := quiz.Options{
+			ModeID:   mode.ID,
+			ModeName: mode.Name,
+			Count:    quizCount,
+			JSON:     quizJSON,
+		}
+		if !quizNoSave {
+			opts.Save = func(s *game.Session, elapsed time.Duration) (string, error) {
+				return recordSession(s, mode.Name, elapsed)
+			}
+		}
+
+		// Count-based: the session timer is not used.
+		session := game.NewSession(g, diff, 0)
+		if _, err := quiz.Run(os.Stdin, os.Stdout, session, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+	ValidArgsFunction: completeHeadlessModeArg,
+}
+
+// recordSession saves a finished session to statistics and returns its ID.
+func recordSession(s *game.Session, modeName string, elapsed time.Duration) (string, error) {
+	record, err := history.NewRecord(s, modeName, elapsed)
+	if err != nil {
+		return "", err
+	}
+	if err := history.Save(record); err != nil {
+		return "", err
+	}
+	return record.ID, nil
+}
+
+func init() {
+	quizCmd.Flags().StringVarP(&quizDifficulty, "difficulty", "d", "", "difficulty (beginner, easy, medium, hard, expert; default: mode default)")
+	quizCmd.Flags().IntVarP(&quizCount, "count", "n", 10, "number of questions (0 = until end of input)")
+	quizCmd.Flags().BoolVar(&quizJSON, "json", false, "use the JSON line protocol")
+	quizCmd.Flags().BoolVar(&quizNoSave, "no-save", false, "do not record the session in statistics")
+	_ = quizCmd.RegisterFlagCompletionFunc("difficulty", completeDifficulties)
+	rootCmd.AddCommand(quizCmd)
+}
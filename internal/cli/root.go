@@ -3,36 +3,76 @@ package cli
 import (
 	"fmt"
 	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	// Registers generators (must come before modes.RegisterPresets)
+	"github.com/gurselcakar/arithmego/internal/game/gen"
 	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui"
-
-	// Register generators (must come before modes.RegisterPresets)
-	_ "github.com/gurselcakar/arithmego/internal/game/gen"
 )
 
 // Version is set via ldflags during build.
 var Version = "dev"
 
+// profileFlag is the --profile value shared by all commands.
+var profileFlag string
+
 var rootCmd = &cobra.Command{
 	Use:   "arithmego",
 	Short: "Terminal-based arithmetic game for developers",
 	Long: `ArithmeGo is a terminal-based arithmetic game designed for developers.
 Short sessions. Minimal friction. Never leave the terminal.
 
-Run without arguments to open the main menu.`,
+Run without arguments to open the main menu.
+Use --profile to play as a named profile with its own settings and history.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if err := selectProfile(profileFlag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		// Questions follow the profile's notation and flash options
+		if config, err := storage.LoadConfig(); err == nil {
+			_ = expr.Apply(config.Notation)
+			gen.SetFlashOptions(config.FlashLength, time.Duration(config.FlashIntervalMs)*time.Millisecond)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		runTUI(ui.StartModeMenu)
 	},
 }
 
+// selectProfile activates the profile given by --profile, or the profile
+// last chosen in the menu when the flag is empty.
+func selectProfile(name string) error {
+	if name == "" {
+		saved, err := storage.LoadSelectedProfile()
+		if err != nil {
+			// Selection is non-critical; stay on the default profile.
+			return nil
+		}
+		return storage.SetActiveProfile(saved)
+	}
+
+	exists, err := storage.ProfileExists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("profile %q does not exist (create it with 'arithmego profile create %s')", name, name)
+	}
+	return storage.SetActiveProfile(name)
+}
+
 // Execute runs the root command.
 func Execute() {
 	// Initialize modes
 	modes.RegisterPresets()
+	expandModeHelp(rootCmd)
 
 	// Note: Update check is now handled within the TUI (see ui/app.go)
 	// This allows the notification to be displayed in the menu screen.
@@ -56,8 +96,12 @@ func runTUI(startMode ui.StartMode) {
 	// Set version for update checking within TUI
 	ui.Version = Version
 
-	app := ui.NewWithStartMode(startMode)
-	p := tea.NewProgram(app, tea.WithAltScreen())
+	runProgram(ui.NewWithStartMode(startMode))
+}
+
+// runProgram runs a Bubble Tea model in the alternate screen.
+func runProgram(model tea.Model) {
+	p := tea.NewProgram(model, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
@@ -65,6 +109,9 @@ func runTUI(startMode ui.StartMode) {
 }
 
 func init() {
-	// Disable Cobra's default completion command
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "profile to use (default: last selected)")
+	_ = rootCmd.RegisterFlagCompletionFunc("profile", completeProfiles)
+
+	// Replace Cobra's default completion command with our own (see completion.go)
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 }
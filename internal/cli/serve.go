@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/server"
+)
+
+var (
+	serveAddr   string
+	serveNoSave bool
+)
+
+// serveShutdownTimeout bounds how long in-flight requests may run on exit.
+const serveShutdownTimeout = 5 * time.Second
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the game over a local HTTP/JSON API",
+	Long: `Run a local HTTP server that plays games and reads statistics over JSON,
+for web pages and editor plugins. Sessions are scored like the TUI and saved
+to statistics when they finish. Unfinished sessions are saved on exit.
+
+Endpoints:
+  GET    /api/modes
+  POST   /api/sessions                {"mode": "addition", "difficulty": "easy", "duration": "60s"}
+  GET    /api/sessions/{id}
+  GET    /api/sessions/{id}/question
+  POST   /api/sessions/{id}/answer    {"answer": 12}
+  POST   /api/sessions/{id}/skip
+  POST   /api/sessions/{id}/end
+  DELETE /api/sessions/{id}
+  GET    /api/statistics              ?period=7d&difficulty=hard&category=basic&mode=addition
+  GET    /api/statistics/history      same filters, plus ?limit=20
+
+The server has no authentication; keep it on a loopback address. Requests
+must be addressed to the --addr host or localhost, POST bodies must be
+application/json, and browsers may only call it from pages on the same host
+or on localhost.
+
+Examples:
+  arithmego serve
+  arithmego serve --addr 127.0.0.1:9000`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := server.Options{Addr: serveAddr}
+		if !serveNoSave {
+			opts.Save = recordSession
+		}
+		srv := server.New(opts)
+
+		ln, err := net.Listen("tcp", serveAddr)
+		exitOnError(err)
+
+		httpServer := &http.Server{
+			Handler:           srv.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		errc := make(chan error, 1)
+		go func() { errc <- httpServer.Serve(ln) }()
+		fmt.Fprintf(os.Stderr, "Serving on http://%s (Ctrl+C to stop)\n", ln.Addr())
+
+		select {
+		case err := <-errc:
+			srv.Close()
+			exitOnError(err)
+		case <-ctx.Done():
+		}
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		srv.Close()
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:8080", "address to listen on")
+	serveCmd.Flags().BoolVar(&serveNoSave, "no-save", false, "do not record sessions in statistics")
+	rootCmd.AddCommand(serveCmd)
+}
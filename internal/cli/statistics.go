@@ -14,7 +14,17 @@ var statisticsCmd = &cobra.Command{
 
 The dashboard shows overall accuracy, personal bests, and insights.
 Navigate between views using: O (Operations), H (History), T (Trends).
-Press Esc to return to menu.`,
+Press Esc to return to menu.
+
+For scripts and dashboards, the subcommands print the same data as aligned
+tables, or as JSON with --json:
+
+  arithmego stats summary --period 7d
+  arithmego stats operations --category basic --json
+  arithmego stats history --mode addition --limit 10
+  arithmego stats session <id>
+  arithmego stats trends --period 30d --difficulty hard`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		runTUI(ui.StartModeStatistics)
 	},
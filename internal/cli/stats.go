@@ -0,0 +1,465 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// statsDateLayout is used for dates in table output.
+const statsDateLayout = "2006-01-02 15:04"
+
+var (
+	statsPeriod     string
+	statsDifficulty string
+	statsCategory   string
+	statsMode       string
+	statsJSON       bool
+	statsLimit      int
+)
+
+var statsSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Print overall totals and personal bests",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, filter := loadStatsForFlags()
+		agg := analytics.ComputeFilteredAggregates(stats, filter)
+		exitOnError(printStatsSummary(os.Stdout, agg, statsJSON))
+	},
+}
+
+var statsOperationsCmd = &cobra.Command{
+	Use:   "operations",
+	Short: "Print accuracy and speed per operation",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, filter := loadStatsForFlags()
+		agg := analytics.ComputeFilteredAggregates(stats, filter)
+		exitOnError(printStatsOperations(os.Stdout, agg, statsJSON))
+	},
+}
+
+var statsHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Print recent sessions",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, filter := loadStatsForFlags()
+		sessions := analytics.GetSessionsByFilter(stats, filter)
+		if statsLimit > 0 && len(sessions) > statsLimit {
+			sessions = sessions[:statsLimit]
+		}
+		exitOnError(printStatsHistory(os.Stdout, sessions, statsJSON))
+	},
+}
+
+var statsSessionCmd = &cobra.Command{
+	Use:   "session <id>",
+	Short: "Print one session question by question",
+	Long: `Print one recorded session with every question, answer and response time.
+Session IDs are listed by 'stats history --json' and complete with Tab.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, err := queryStatistics(analytics.AggregateFilter{})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading statistics: %v\n", err)
+			fmt.Fprintln(os.Stderr, "Run 'arithmego statistics' to repair a damaged statistics file.")
+			os.Exit(1)
+		}
+		session, err := findSession(stats, args[0])
+		exitOnError(err)
+		exitOnError(printStatsSession(os.Stdout, session, statsJSON))
+	},
+	ValidArgsFunction: completeSessionArg,
+}
+
+var statsTrendsCmd = &cobra.Command{
+	Use:   "trends",
+	Short: "Print daily trends and insights",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		stats, filter := loadStatsForFlags()
+		trends := analytics.ComputeTrendData(stats, filter.TimePeriod)
+		insights := analytics.GenerateInsights(stats, filter.TimePeriod)
+		exitOnError(printStatsTrends(os.Stdout, trends, insights, statsJSON))
+	},
+}
+
+// loadStatsForFlags parses the filter flags and loads matching sessions.
+// Exits on invalid flags or load errors.
+func loadStatsForFlags() (*storage.Statistics, analytics.AggregateFilter) {
+	filter, err := statsFilterFromFlags(statsPeriod, statsDifficulty, statsCategory, statsMode)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	stats, err := queryStatistics(filter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading statistics: %v\n", err)
+		fmt.Fprintln(os.Stderr, "Run 'arithmego statistics' to repair a damaged statistics file.")
+		os.Exit(1)
+	}
+	return stats, filter
+}
+
+// statsFilterFromFlags builds an aggregate filter from the stats flags.
+// Values are matched case-insensitively; mode is a mode ID.
+func statsFilterFromFlags(period, difficulty, category, modeID string) (analytics.AggregateFilter, error) {
+	filter, err := analytics.ParseAggregateFilter(period, difficulty, category)
+	if err != nil {
+		return filter, err
+	}
+
+	if modeID != "" {
+		mode, ok := modes.Get(modeID)
+		if !ok {
+			return filter, fmt.Errorf("unknown mode %q", modeID)
+		}
+		filter.Mode = mode.Name
+	}
+
+	return filter, nil
+}
+
+// exitOnError prints err and exits if it is non-nil.
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// formatMs formats milliseconds as seconds ("1.2s"), or "-" for zero.
+func formatMs(ms int64) string {
+	if ms <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1fs", float64(ms)/1000)
+}
+
+// statsSummary is the JSON form of the summary subcommand.
+type statsSummary struct {
+	Sessions          int       `json:"sessions"`
+	Questions         int       `json:"questions"`
+	Correct           int       `json:"correct"`
+	Accuracy          float64   `json:"accuracy"`
+	TotalPoints       int       `json:"total_points"`
+	BestStreak        int       `json:"best_streak"`
+	BestScore         int       `json:"best_score"`
+	BestAccuracy      float64   `json:"best_accuracy"`
+	FastestAvgMs      int64     `json:"fastest_avg_response_ms"`
+	AvgResponseMs     int64     `json:"avg_response_ms"`
+	FastestResponseMs int64     `json:"fastest_response_ms"`
+	LastPlayedAt      time.Time `json:"last_played_at,omitzero"`
+}
+
+func printStatsSummary(w io.Writer, agg analytics.ExtendedAggregates, asJSON bool) error {
+	summary := statsSummary{
+		Sessions:          agg.TotalSessions,
+		Questions:         agg.TotalQuestions,
+		Correct:           agg.TotalCorrect,
+		Accuracy:          agg.OverallAccuracy,
+		TotalPoints:       agg.TotalPoints,
+		BestStreak:        agg.PersonalBests.BestStreak,
+		BestScore:         agg.PersonalBests.BestScore,
+		BestAccuracy:      agg.PersonalBests.BestAccuracy,
+		FastestAvgMs:      agg.PersonalBests.FastestAvgTime,
+		AvgResponseMs:     agg.AvgResponseTimeMs,
+		FastestResponseMs: agg.FastestResponseMs,
+		LastPlayedAt:      agg.LastPlayedAt,
+	}
+	if asJSON {
+		return writeJSON(w, summary)
+	}
+
+	lastPlayed := "-"
+	if !summary.LastPlayedAt.IsZero() {
+		lastPlayed = summary.LastPlayedAt.Local().Format(statsDateLayout)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Sessions\t%d\n", summary.Sessions)
+	fmt.Fprintf(tw, "Questions\t%d\n", summary.Questions)
+	fmt.Fprintf(tw, "Accuracy\t%.1f%%\n", summary.Accuracy)
+	fmt.Fprintf(tw, "Total points\t%d\n", summary.TotalPoints)
+	fmt.Fprintf(tw, "Best streak\t%d\n", summary.BestStreak)
+	fmt.Fprintf(tw, "Best score\t%d\n", summary.BestScore)
+	fmt.Fprintf(tw, "Best accuracy\t%.1f%%\n", summary.BestAccuracy)
+	fmt.Fprintf(tw, "Fastest session avg\t%s\n", formatMs(summary.FastestAvgMs))
+	fmt.Fprintf(tw, "Avg response\t%s\n", formatMs(summary.AvgResponseMs))
+	fmt.Fprintf(tw, "Fastest response\t%s\n", formatMs(summary.FastestResponseMs))
+	fmt.Fprintf(tw, "Last played\t%s\n", lastPlayed)
+	return tw.Flush()
+}
+
+// statsOperation is the JSON form of one row of the operations subcommand.
+type statsOperation struct {
+	Operation     string                        `json:"operation"`
+	Category      string                        `json:"category"`
+	Questions     int                           `json:"questions"`
+	Correct       int                           `json:"correct"`
+	Accuracy      float64                       `json:"accuracy"`
+	AvgResponseMs int64                         `json:"avg_response_ms"`
+	FastestMs     int64                         `json:"fastest_response_ms"`
+	ByDifficulty  map[string]statsDifficultyRow `json:"by_difficulty"`
+}
+
+type statsDifficultyRow struct {
+	Questions int     `json:"questions"`
+	Correct   int     `json:"correct"`
+	Accuracy  float64 `json:"accuracy"`
+}
+
+func printStatsOperations(w io.Writer, agg analytics.ExtendedAggregates, asJSON bool) error {
+	rows := make([]statsOperation, 0, len(agg.ByOperationExtended))
+	for op, s := range agg.ByOperationExtended {
+		row := statsOperation{
+			Operation:     op,
+			Category:      analytics.GetOperationCategory(op),
+			Questions:     s.Total,
+			Correct:       s.Correct,
+			Accuracy:      s.Accuracy,
+			AvgResponseMs: s.AvgResponseTimeMs,
+			FastestMs:     s.FastestTimeMs,
+			ByDifficulty:  make(map[string]statsDifficultyRow, len(s.ByDifficulty)),
+		}
+		for diff, d := range s.ByDifficulty {
+			row.ByDifficulty[diff] = statsDifficultyRow{Questions: d.Total, Correct: d.Correct, Accuracy: d.Accuracy}
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Questions != rows[j].Questions {
+			return rows[i].Questions > rows[j].Questions
+		}
+		return rows[i].Operation < rows[j].Operation
+	})
+
+	if asJSON {
+		return writeJSON(w, rows)
+	}
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No questions match the filters.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "OPERATION\tCATEGORY\tQUESTIONS\tACCURACY\tAVG\tFASTEST")
+	for _, r := range rows {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f%%\t%s\t%s\n",
+			r.Operation, r.Category, r.Questions, r.Accuracy, formatMs(r.AvgResponseMs), formatMs(r.FastestMs))
+	}
+	return tw.Flush()
+}
+
+// statsSession is the JSON form of one row of the history subcommand.
+type statsSession struct {
+	ID            string    `json:"id"`
+	Timestamp     time.Time `json:"timestamp"`
+	Mode          string    `json:"mode"`
+	Difficulty    string    `json:"difficulty"`
+	Score         int       `json:"score"`
+	Attempted     int       `json:"questions_attempted"`
+	Correct       int       `json:"questions_correct"`
+	Accuracy      float64   `json:"accuracy"`
+	BestStreak    int       `json:"best_streak"`
+	AvgResponseMs int64     `json:"avg_response_ms"`
+}
+
+func printStatsHistory(w io.Writer, sessions []storage.SessionRecord, asJSON bool) error {
+	rows := make([]statsSession, 0, len(sessions))
+	for _, s := range sessions {
+		row := statsSession{
+			ID:            s.ID,
+			Timestamp:     s.Timestamp,
+			Mode:          s.Mode,
+			Difficulty:    s.Difficulty,
+			Score:         s.Score,
+			Attempted:     s.QuestionsAttempted,
+			Correct:       s.QuestionsCorrect,
+			BestStreak:    s.BestStreak,
+			AvgResponseMs: s.AvgResponseTimeMs,
+		}
+		if s.QuestionsAttempted > 0 {
+			row.Accuracy = float64(s.QuestionsCorrect) / float64(s.QuestionsAttempted) * 100
+		}
+		rows = append(rows, row)
+	}
+
+	if asJSON {
+		return writeJSON(w, rows)
+	}
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No sessions match the filters.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DATE\tMODE\tDIFFICULTY\tSCORE\tCORRECT\tACCURACY\tSTREAK")
+	for _, r := range rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d/%d\t%.0f%%\t%d\n",
+			r.Timestamp.Local().Format(statsDateLayout), r.Mode, r.Difficulty,
+			r.Score, r.Correct, r.Attempted, r.Accuracy, r.BestStreak)
+	}
+	return tw.Flush()
+}
+
+// findSession returns the session with the given ID.
+func findSession(stats *storage.Statistics, id string) (storage.SessionRecord, error) {
+	for _, s := range stats.Sessions {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return storage.SessionRecord{}, fmt.Errorf("no session with ID %q", id)
+}
+
+// printStatsSession writes a session and its questions as a table, or the
+// stored record as JSON.
+func printStatsSession(w io.Writer, s storage.SessionRecord, asJSON bool) error {
+	if asJSON {
+		return writeJSON(w, s)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Date\t%s\n", s.Timestamp.Local().Format(statsDateLayout))
+	fmt.Fprintf(tw, "Mode\t%s (%s)\n", s.Mode, s.Difficulty)
+	fmt.Fprintf(tw, "Score\t%d\n", s.Score)
+	fmt.Fprintf(tw, "Correct\t%d/%d\n", s.QuestionsCorrect, s.QuestionsAttempted)
+	fmt.Fprintf(tw, "Best streak\t%d\n", s.BestStreak)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(s.Questions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "#\tQUESTION\tANSWER\tCORRECT\tTIME\tPOINTS")
+	for i, q := range s.Questions {
+		answer := fmt.Sprint(q.UserAnswer)
+		switch {
+		case q.TimedOut:
+			answer = "timed out"
+		case q.Skipped:
+			answer = "skipped"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%d\n",
+			i+1, q.Question, answer, q.CorrectAnswer, formatMs(q.ResponseTimeMs), q.PointsEarned)
+	}
+	return tw.Flush()
+}
+
+// statsTrends is the JSON form of the trends subcommand.
+type statsTrends struct {
+	Points          []statsTrendPoint `json:"points"`
+	AccuracyChange  float64           `json:"accuracy_change"`
+	SessionsPerWeek []statsWeek       `json:"sessions_per_week"`
+	Insights        []string          `json:"insights"`
+}
+
+type statsTrendPoint struct {
+	Date          string  `json:"date"`
+	Sessions      int     `json:"sessions"`
+	Accuracy      float64 `json:"accuracy"`
+	TotalScore    int     `json:"total_score"`
+	AvgResponseMs int64   `json:"avg_response_ms"`
+}
+
+type statsWeek struct {
+	WeekStart string `json:"week_start"`
+	Sessions  int    `json:"sessions"`
+}
+
+func printStatsTrends(w io.Writer, data analytics.TrendData, insights []analytics.Insight, asJSON bool) error {
+	out := statsTrends{
+		Points:          make([]statsTrendPoint, 0, len(data.Points)),
+		AccuracyChange:  data.AccuracyChange,
+		SessionsPerWeek: make([]statsWeek, 0, len(data.SessionsPerWeek)),
+		Insights:        make([]string, 0, len(insights)),
+	}
+	for _, p := range data.Points {
+		out.Points = append(out.Points, statsTrendPoint{
+			Date:          p.Date.Format(sinceLayout),
+			Sessions:      p.Sessions,
+			Accuracy:      p.Accuracy,
+			TotalScore:    p.TotalScore,
+			AvgResponseMs: p.AvgResponseTime,
+		})
+	}
+	for _, wk := range data.SessionsPerWeek {
+		out.SessionsPerWeek = append(out.SessionsPerWeek, statsWeek{
+			WeekStart: wk.WeekStart.Format(sinceLayout),
+			Sessions:  wk.Sessions,
+		})
+	}
+	for _, in := range insights {
+		out.Insights = append(out.Insights, in.Message)
+	}
+
+	if asJSON {
+		return writeJSON(w, out)
+	}
+	if len(out.Points) == 0 {
+		_, err := fmt.Fprintln(w, "No sessions match the filters.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DATE\tSESSIONS\tACCURACY\tSCORE\tAVG")
+	for _, p := range out.Points {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f%%\t%d\t%s\n",
+			p.Date, p.Sessions, p.Accuracy, p.TotalScore, formatMs(p.AvgResponseMs))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nAccuracy change: %+.1f%%\n", out.AccuracyChange)
+	if len(insights) > 0 {
+		fmt.Fprintln(w)
+		for _, in := range insights {
+			fmt.Fprintf(w, "%s %s\n", in.Icon, in.Message)
+		}
+	}
+	return nil
+}
+
+func init() {
+	// Categories group operations; history and trends list whole sessions
+	for _, cmd := range []*cobra.Command{statsSummaryCmd, statsOperationsCmd} {
+		cmd.Flags().StringVar(&statsCategory, "category", "", "only operations in this category: basic, power or advanced")
+	}
+	for _, cmd := range []*cobra.Command{statsSummaryCmd, statsOperationsCmd, statsHistoryCmd, statsTrendsCmd} {
+		cmd.Flags().StringVar(&statsPeriod, "period", "all", "time period: all, 7d, 14d, 30d or 90d")
+		cmd.Flags().StringVar(&statsDifficulty, "difficulty", "", "only this difficulty")
+		cmd.Flags().StringVar(&statsMode, "mode", "", "only sessions of this mode ID")
+		cmd.Flags().BoolVar(&statsJSON, "json", false, "print JSON instead of a table")
+		completeStatsFilters(cmd)
+		statisticsCmd.AddCommand(cmd)
+	}
+	statsHistoryCmd.Flags().IntVarP(&statsLimit, "limit", "n", 20, "maximum number of sessions (0 = all)")
+
+	// A single session takes no filters
+	statsSessionCmd.Flags().BoolVar(&statsJSON, "json", false, "print the stored record as JSON")
+	statisticsCmd.AddCommand(statsSessionCmd)
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/status"
+)
+
+// defaultStatusFormat is the status line used without --format.
+const defaultStatusFormat = "{{.Today}} today · {{.Streak}}d streak · last {{.LastScore}} · {{.Due}} due"
+
+var (
+	statusFormat    string
+	statusPrintTmux bool
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print a one-line summary for prompts and tmux",
+	Long: `Print a one-line summary of today's practice for a shell prompt or
+tmux status bar.
+
+The summary is cached next to the statistics and only rebuilt when they
+change, so the command is cheap to run every few seconds.
+
+--format takes a Go template with these fields:
+  {{.Today}}       sessions played today
+  {{.Streak}}      consecutive days played, ending today or yesterday
+  {{.Sessions}}    sessions played in total
+  {{.LastScore}}   score of the most recent session
+  {{.LastMode}}    mode of the most recent session
+  {{.LastPlayed}}  time of the most recent session
+  {{.Due}}         questions whose latest answer was wrong
+
+Examples:
+  arithmego status
+  arithmego status --format '{{.Streak}}🔥 {{.Due}} due'
+  arithmego status --print-tmux >> ~/.tmux.conf`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if statusPrintTmux {
+			printTmuxSnippet(os.Stdout, profileFlag)
+			return
+		}
+
+		tmpl, err := parseStatusFormat(statusFormat)
+		exitOnError(err)
+		summary, err := status.Load()
+		exitOnError(err)
+		exitOnError(printStatus(os.Stdout, tmpl, summary))
+	},
+}
+
+// parseStatusFormat parses a --format template.
+func parseStatusFormat(format string) (*template.Template, error) {
+	tmpl, err := template.New("status").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format: %w", err)
+	}
+	return tmpl, nil
+}
+
+// printStatus renders the summary as a single line.
+func printStatus(w io.Writer, tmpl *template.Template, summary status.Summary) error {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, summary); err != nil {
+		return fmt.Errorf("invalid --format: %w", err)
+	}
+	_, err := fmt.Fprintln(w, strings.TrimRight(b.String(), "\n"))
+	return err
+}
+
+// printTmuxSnippet prints tmux configuration that shows the status line.
+// A non-empty profile is passed on to the status command.
+func printTmuxSnippet(w io.Writer, profile string) {
+	command := "arithmego status"
+	if profile != "" {
+		command += " --profile " + profile
+	}
+
+	fmt.Fprintf(w, `# ArithmeGo status line. Add to ~/.tmux.conf, then run:
+#   tmux source-file ~/.tmux.conf
+set -g status-interval 15
+set -g status-right-length 80
+set -g status-right '#(%[1]s) | %%H:%%M '
+
+# Streak and reviews only:
+# set -g status-right '#(%[1]s --format "{{.Streak}}d · {{.Due}} due") '
+`, command)
+}
+
+func init() {
+	statusCmd.Flags().StringVar(&statusFormat, "format", defaultStatusFormat, "Go template for the status line")
+	statusCmd.Flags().BoolVar(&statusPrintTmux, "print-tmux", false, "print a tmux status-right snippet and exit")
+	rootCmd.AddCommand(statusCmd)
+}
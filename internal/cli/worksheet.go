@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/worksheet"
+)
+
+var (
+	worksheetDifficulty string
+	worksheetCount      int
+	worksheetFormat     string
+	worksheetAnswers    bool
+	worksheetSeed       int64
+	worksheetNotation   string
+)
+
+var worksheetCmd = &cobra.Command{
+	Use:   "worksheet <mode>",
+	Short: "Print a worksheet of questions",
+	Long: `Print a printable sheet of questions for a mode to stdout.
+
+Questions come from the same generators as the game, without duplicates.
+Without --count, a sheet has up to 40 questions, fewer if the mode has fewer
+different ones at that difficulty.
+The seed is printed on the sheet; pass it back with --seed to get the same
+questions again. --answers adds an answer key on a separate page.
+Questions are written in the notation setting unless --notation is given.
+
+Examples:
+  arithmego worksheet addition
+  arithmego worksheet multiplication --difficulty hard --count 50 --answers
+  arithmego worksheet mixed-basics --format html --seed 42 > warmup.html
+  arithmego worksheet mixed-powers --notation latex --format markdown`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		mode, ok := modes.Get(args[0])
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", unknownModeMessage(args[0]))
+			os.Exit(1)
+		}
+		if mode.Flashed() {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", flashedModeMessage(mode))
+			os.Exit(1)
+		}
+
+		diff := mode.DefaultDifficulty
+		if worksheetDifficulty != "" {
+			var err error
+			diff, err = game.ParseDifficulty(worksheetDifficulty)
+			exitOnError(err)
+		}
+
+		format, err := worksheet.ParseFormat(worksheetFormat)
+		exitOnError(err)
+
+		if worksheetNotation != "" {
+			r, err := expr.Lookup(strings.ToLower(worksheetNotation))
+			exitOnError(err)
+			expr.Use(r)
+		}
+
+		g, ok := gen.Get(mode.GeneratorLabel)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: no generator for mode %q\n", mode.ID)
+			os.Exit(1)
+		}
+
+		sheet, err := worksheet.New(g, worksheet.Options{
+			Title:      mode.Name,
+			Difficulty: diff,
+			Count:      worksheetCount,
+			Seed:       worksheetSeed,
+			// The default count is a suggestion; an explicit one is not
+			AllowFewer: !cmd.Flags().Changed("count"),
+		})
+		exitOnError(err)
+		if n := len(sheet.Questions); n < worksheetCount {
+			fmt.Fprintf(os.Stderr, "Only %d different questions are available at this difficulty; the sheet has %d.\n", n, n)
+		}
+		exitOnError(sheet.Write(os.Stdout, format, worksheetAnswers))
+	},
+	ValidArgsFunction: completeHeadlessModeArg,
+}
+
+func init() {
+	formats := make([]string, 0, len(worksheet.AllFormats()))
+	for _, f := range worksheet.AllFormats() {
+		formats = append(formats, string(f))
+	}
+
+	worksheetCmd.Flags().StringVarP(&worksheetDifficulty, "difficulty", "d", "", "difficulty (beginner, easy, medium, hard, expert; default: mode default)")
+	worksheetCmd.Flags().IntVarP(&worksheetCount, "count", "n", 40, fmt.Sprintf("number of questions (1-%d)", worksheet.MaxCount))
+	worksheetCmd.Flags().StringVar(&worksheetFormat, "format", "txt", "output format ("+strings.Join(formats, ", ")+")")
+	worksheetCmd.Flags().BoolVar(&worksheetAnswers, "answers", false, "add an answer key on a separate page")
+	worksheetCmd.Flags().Int64Var(&worksheetSeed, "seed", 0, "random seed for reproducible sheets (default: random)")
+	worksheetCmd.Flags().StringVar(&worksheetNotation, "notation", "", "how questions are written ("+strings.Join(expr.Notations(), ", ")+"; default: notation setting)")
+	_ = worksheetCmd.RegisterFlagCompletionFunc("difficulty", completeDifficulties)
+	_ = worksheetCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(formats, cobra.ShellCompDirectiveNoFileComp))
+	_ = worksheetCmd.RegisterFlagCompletionFunc("notation", cobra.FixedCompletions(expr.Notations(), cobra.ShellCompDirectiveNoFileComp))
+	rootCmd.AddCommand(worksheetCmd)
+}
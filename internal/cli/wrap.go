@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/wrap"
+)
+
+// wrapLogFile is the name of the captured output file in the config directory.
+const wrapLogFile = "wrap.log"
+
+var (
+	wrapOnExit string
+	wrapQuiet  bool
+)
+
+var wrapCmd = &cobra.Command{
+	Use:   "wrap -- <command> [args...]",
+	Short: "Play while a long-running command runs",
+	Long: `Run a command in the background and play while it works.
+
+A status line below the game shows whether the command is still running and
+its latest output. When the command exits, the status line flashes (or, with
+--on-exit quit, the game ends). The last megabyte of its output is printed
+after you leave the game, and all of it is saved to wrap.log in the config
+directory.
+
+arithmego exits with the command's exit code. If you leave the game before
+the command is done, arithmego waits for it to finish.
+
+Examples:
+  arithmego wrap -- make test
+  arithmego wrap --on-exit quit -- go build ./...`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var onExit ui.WrapOnExit
+		switch wrapOnExit {
+		case "notify":
+			onExit = ui.WrapOnExitNotify
+		case "quit":
+			onExit = ui.WrapOnExitQuit
+		default:
+			fmt.Fprintf(os.Stderr, "Error: invalid --on-exit %q (want notify or quit)\n", wrapOnExit)
+			os.Exit(1)
+		}
+
+		logPath := ""
+		if dir, err := storage.ConfigDir(); err == nil {
+			logPath = filepath.Join(dir, wrapLogFile)
+		}
+
+		child, err := wrap.Start(args, logPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(wrap.ExitCodeFailedToRun)
+		}
+
+		ui.Version = Version
+		runProgram(ui.NewWrap(ui.NewWithStartMode(ui.StartModeMenu), child, onExit))
+
+		waitForChild(child)
+
+		if !wrapQuiet {
+			_ = child.WriteOutput(os.Stdout)
+		}
+
+		status := child.Status()
+		if status.Err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", child.CommandLine(), status.Err)
+		}
+		fmt.Fprintf(os.Stderr, "%s exited with code %d after %s\n",
+			child.CommandLine(), status.ExitCode, components.FormatTimer(status.Elapsed))
+		if logPath != "" {
+			fmt.Fprintf(os.Stderr, "Output saved to %s\n", logPath)
+		}
+		os.Exit(status.ExitCode)
+	},
+}
+
+// waitForChild blocks until the child exits. Interrupts are not fatal here:
+// they reach the child through the terminal, and its exit status is reported.
+func waitForChild(child *wrap.Child) {
+	select {
+	case <-child.Done():
+		return
+	default:
+	}
+
+	fmt.Fprintf(os.Stderr, "Waiting for %s to finish (Ctrl+C to stop it)...\n", child.CommandLine())
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	<-child.Done()
+}
+
+func init() {
+	wrapCmd.Flags().StringVar(&wrapOnExit, "on-exit", "notify", "when the command exits: notify (flash the status line) or quit (end the game)")
+	_ = wrapCmd.RegisterFlagCompletionFunc("on-exit", cobra.FixedCompletions([]string{"notify", "quit"}, cobra.ShellCompDirectiveNoFileComp))
+	wrapCmd.Flags().BoolVarP(&wrapQuiet, "quiet", "q", false, "do not print the command's output afterwards")
+	// Flags after the command name belong to the command.
+	wrapCmd.Flags().SetInterspersed(false)
+	rootCmd.AddCommand(wrapCmd)
+}
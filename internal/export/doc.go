@@ -0,0 +1,24 @@
+// Package export writes recorded game sessions to portable file formats.
+//
+// Three formats are supported:
+//
+//   - CSV: One row per question (default) or one row per session, for spreadsheets.
+//     Question rows include response time, points, skipped flag and operation.
+//   - JSON: A single [File] document whose "sessions" key matches statistics.json.
+//   - JSONL: One [storage.SessionRecord] per line, for notebooks and streaming tools.
+//
+// Sessions are selected with an [analytics.AggregateFilter], the same filter
+// used by the statistics screen:
+//
+//	stats, _ := storage.Load()
+//	n, err := export.Write(os.Stdout, stats, export.Options{
+//	    Format: export.FormatCSV,
+//	    Filter: analytics.AggregateFilter{Mode: "Addition"},
+//	})
+//
+// Question text is written as stored, in the notation the session was played
+// in; exports are not re-rendered in the current notation.
+//
+// [ReadSessions] reads any of the JSON forms back (including statistics.json),
+// which is how histories from other machines are imported.
+package export
@@ -0,0 +1,221 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// Format identifies an export file format.
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSON  Format = "json"
+	FormatJSONL Format = "jsonl"
+)
+
+// AllFormats returns all supported export formats.
+func AllFormats() []Format {
+	return []Format{FormatCSV, FormatJSON, FormatJSONL}
+}
+
+// ParseFormat converts a string to a Format.
+// Returns an error for unrecognized formats.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range AllFormats() {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown format %q (want csv, json or jsonl)", s)
+}
+
+// Rows selects which rows a CSV export contains.
+type Rows string
+
+const (
+	// RowsQuestions writes one row per question, with its session's columns repeated.
+	RowsQuestions Rows = "questions"
+	// RowsSessions writes one row per session.
+	RowsSessions Rows = "sessions"
+)
+
+// ParseRows converts a string to a Rows value.
+func ParseRows(s string) (Rows, error) {
+	switch Rows(s) {
+	case RowsQuestions, RowsSessions:
+		return Rows(s), nil
+	default:
+		return "", fmt.Errorf("unknown rows %q (want questions or sessions)", s)
+	}
+}
+
+// FileVersion is the version written to JSON exports.
+const FileVersion = 1
+
+// File is the JSON export document. Its "sessions" key matches statistics.json,
+// so an export can be read anywhere a statistics file can.
+type File struct {
+	Version    int                     `json:"version"`
+	ExportedAt time.Time               `json:"exported_at"`
+	Sessions   []storage.SessionRecord `json:"sessions"`
+}
+
+// Options controls what an export contains.
+type Options struct {
+	Format Format
+	Rows   Rows // CSV only; defaults to RowsQuestions
+	Filter analytics.AggregateFilter
+}
+
+// SelectSessions returns the sessions matching the filter in chronological order.
+// Category and operation filters also drop non-matching questions from each session.
+func SelectSessions(stats *storage.Statistics, filter analytics.AggregateFilter) []storage.SessionRecord {
+	sessions := analytics.GetSessionsByFilter(stats, filter)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Timestamp.Before(sessions[j].Timestamp)
+	})
+
+	if filter.Category == "" && filter.Operation == "" {
+		return sessions
+	}
+
+	for i := range sessions {
+		questions := make([]storage.QuestionRecord, 0, len(sessions[i].Questions))
+		for _, q := range sessions[i].Questions {
+			if analytics.QuestionMatchesFilter(q, filter) {
+				questions = append(questions, q)
+			}
+		}
+		sessions[i].Questions = questions
+	}
+	return sessions
+}
+
+// Write exports the selected sessions to w in the requested format.
+// Returns the number of sessions written.
+func Write(w io.Writer, stats *storage.Statistics, opts Options) (int, error) {
+	sessions := SelectSessions(stats, opts.Filter)
+
+	var err error
+	switch opts.Format {
+	case FormatCSV:
+		if opts.Rows == RowsSessions {
+			err = writeSessionsCSV(w, sessions)
+		} else {
+			err = writeQuestionsCSV(w, sessions)
+		}
+	case FormatJSON:
+		err = writeJSON(w, sessions)
+	case FormatJSONL:
+		err = writeJSONL(w, sessions)
+	default:
+		err = fmt.Errorf("unknown format %q", opts.Format)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(sessions), nil
+}
+
+// sessionColumns are shared by both CSV layouts.
+var sessionColumns = []string{"session_id", "timestamp", "mode", "difficulty"}
+
+// questionColumns are the per-question CSV columns.
+var questionColumns = []string{
+	"question_index", "question", "operation", "correct_answer", "user_answer",
+	"correct", "skipped", "response_time_ms", "points",
+}
+
+// summaryColumns are the per-session CSV columns.
+var summaryColumns = []string{
+	"duration_seconds", "questions_attempted", "questions_correct", "questions_wrong",
+	"questions_skipped", "score", "best_streak", "avg_response_time_ms",
+}
+
+func sessionFields(s storage.SessionRecord) []string {
+	return []string{s.ID, s.Timestamp.Format(time.RFC3339), s.Mode, s.Difficulty}
+}
+
+func writeQuestionsCSV(w io.Writer, sessions []storage.SessionRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(append([]string{}, sessionColumns...), questionColumns...)); err != nil {
+		return err
+	}
+
+	for _, s := range sessions {
+		for i, q := range s.Questions {
+			row := append(sessionFields(s),
+				strconv.Itoa(i+1),
+				q.Question,
+				q.Operation,
+				strconv.Itoa(q.CorrectAnswer),
+				strconv.Itoa(q.UserAnswer),
+				strconv.FormatBool(q.Correct),
+				strconv.FormatBool(q.Skipped),
+				strconv.FormatInt(q.ResponseTimeMs, 10),
+				strconv.Itoa(q.PointsEarned),
+			)
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeSessionsCSV(w io.Writer, sessions []storage.SessionRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(append([]string{}, sessionColumns...), summaryColumns...)); err != nil {
+		return err
+	}
+
+	for _, s := range sessions {
+		row := append(sessionFields(s),
+			strconv.Itoa(s.DurationSeconds),
+			strconv.Itoa(s.QuestionsAttempted),
+			strconv.Itoa(s.QuestionsCorrect),
+			strconv.Itoa(s.QuestionsWrong),
+			strconv.Itoa(s.QuestionsSkipped),
+			strconv.Itoa(s.Score),
+			strconv.Itoa(s.BestStreak),
+			strconv.FormatInt(s.AvgResponseTimeMs, 10),
+		)
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, sessions []storage.SessionRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(File{
+		Version:    FileVersion,
+		ExportedAt: time.Now(),
+		Sessions:   sessions,
+	})
+}
+
+func writeJSONL(w io.Writer, sessions []storage.SessionRecord) error {
+	enc := json.NewEncoder(w)
+	for _, s := range sessions {
+		if err := enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
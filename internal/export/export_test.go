@@ -0,0 +1,188 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+func testStats() *storage.Statistics {
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	return &storage.Statistics{Sessions: []storage.SessionRecord{
+		{
+			ID:               "s2",
+			Timestamp:        base.Add(24 * time.Hour),
+			Mode:             "Mixed Basics",
+			Difficulty:       "Hard",
+			DurationSeconds:  60,
+			QuestionsCorrect: 1,
+			QuestionsSkipped: 1,
+			Score:            150,
+			Questions: []storage.QuestionRecord{
+				{Question: "6 × 7", Operation: "Multiplication", CorrectAnswer: 42, UserAnswer: 42, Correct: true, ResponseTimeMs: 1800, PointsEarned: 150},
+				{Question: "9 + 3", Operation: "Addition", CorrectAnswer: 12, Skipped: true, ResponseTimeMs: 4000},
+			},
+		},
+		{
+			ID:               "s1",
+			Timestamp:        base,
+			Mode:             "Addition",
+			Difficulty:       "Easy",
+			DurationSeconds:  30,
+			QuestionsCorrect: 1,
+			Score:            75,
+			Questions: []storage.QuestionRecord{
+				{Question: "2 + 2", Operation: "Addition", CorrectAnswer: 4, UserAnswer: 4, Correct: true, ResponseTimeMs: 900, PointsEarned: 75},
+			},
+		},
+	}}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range AllFormats() {
+		got, err := ParseFormat(string(f))
+		if err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %q, %v", f, got, err)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) should fail")
+	}
+}
+
+func TestParseRows(t *testing.T) {
+	if _, err := ParseRows("questions"); err != nil {
+		t.Errorf("ParseRows(questions) error = %v", err)
+	}
+	if _, err := ParseRows("sessions"); err != nil {
+		t.Errorf("ParseRows(sessions) error = %v", err)
+	}
+	if _, err := ParseRows("answers"); err == nil {
+		t.Error("ParseRows(answers) should fail")
+	}
+}
+
+func TestWrite_QuestionsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := Write(&buf, testStats(), Options{Format: FormatCSV})
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Write() = %d sessions, want 2", n)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("got %d rows, want header + 3", len(records))
+	}
+
+	header := strings.Join(records[0], ",")
+	for _, col := range []string{"session_id", "operation", "response_time_ms", "points", "skipped"} {
+		if !strings.Contains(header, col) {
+			t.Errorf("header missing %q: %s", col, header)
+		}
+	}
+
+	// Chronological order: s1 first
+	if records[1][0] != "s1" {
+		t.Errorf("first row session = %q, want s1", records[1][0])
+	}
+	// Skipped question row
+	last := records[3]
+	if last[len(last)-3] != "true" || last[len(last)-2] != "4000" {
+		t.Errorf("unexpected skipped row: %v", last)
+	}
+}
+
+func TestWrite_SessionsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Write(&buf, testStats(), Options{Format: FormatCSV, Rows: RowsSessions}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d rows, want header + 2", len(records))
+	}
+	if records[2][0] != "s2" || records[2][len(records[2])-3] != "150" {
+		t.Errorf("unexpected session row: %v", records[2])
+	}
+}
+
+func TestWrite_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Write(&buf, testStats(), Options{Format: FormatJSON}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	// JSON export must be readable as a statistics file
+	var stats storage.Statistics
+	if err := json.Unmarshal(buf.Bytes(), &stats); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(stats.Sessions) != 2 || stats.Sessions[0].ID != "s1" {
+		t.Errorf("unexpected sessions: %+v", stats.Sessions)
+	}
+
+	var file File
+	_ = json.Unmarshal(buf.Bytes(), &file)
+	if file.Version != FileVersion {
+		t.Errorf("Version = %d, want %d", file.Version, FileVersion)
+	}
+}
+
+func TestWrite_JSONL(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Write(&buf, testStats(), Options{Format: FormatJSONL}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	var lines int
+	for scanner.Scan() {
+		var s storage.SessionRecord
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf("line %d invalid: %v", lines+1, err)
+		}
+		lines++
+	}
+	if lines != 2 {
+		t.Errorf("got %d lines, want 2", lines)
+	}
+}
+
+func TestSelectSessions_Filters(t *testing.T) {
+	stats := testStats()
+
+	byMode := SelectSessions(stats, analytics.AggregateFilter{Mode: "Addition"})
+	if len(byMode) != 1 || byMode[0].ID != "s1" {
+		t.Errorf("mode filter: got %d sessions", len(byMode))
+	}
+
+	since := SelectSessions(stats, analytics.AggregateFilter{Since: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)})
+	if len(since) != 1 || since[0].ID != "s2" {
+		t.Errorf("since filter: got %d sessions", len(since))
+	}
+
+	// Operation filter trims questions but leaves the source untouched
+	byOp := SelectSessions(stats, analytics.AggregateFilter{Operation: "Multiplication"})
+	if len(byOp) != 1 || len(byOp[0].Questions) != 1 {
+		t.Fatalf("operation filter: unexpected result %+v", byOp)
+	}
+	if len(stats.Sessions[0].Questions) != 2 {
+		t.Error("SelectSessions should not modify the source statistics")
+	}
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// maxLineSize bounds a single JSONL line (one session with its questions).
+const maxLineSize = 4 * 1024 * 1024
+
+// ReadSessions reads sessions from a statistics.json file, a JSON export,
+// or a JSONL export. The format is detected from the content.
+func ReadSessions(r io.Reader) ([]storage.SessionRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	// statistics.json and JSON exports are a single document with "sessions".
+	var doc struct {
+		Sessions *[]storage.SessionRecord `json:"sessions"`
+	}
+	if err := json.Unmarshal(trimmed, &doc); err == nil && doc.Sessions != nil {
+		return *doc.Sessions, nil
+	}
+
+	if trimmed[0] != '{' {
+		return nil, errors.New("unrecognized format (want statistics.json, JSON or JSONL export)")
+	}
+
+	return readJSONL(trimmed)
+}
+
+// readJSONL parses one session per non-empty line.
+func readJSONL(data []byte) ([]storage.SessionRecord, error) {
+	var sessions []storage.SessionRecord
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var s storage.SessionRecord
+		if err := json.Unmarshal(text, &s); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
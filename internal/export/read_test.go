@@ -0,0 +1,54 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadSessions_RoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatJSON, FormatJSONL} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if _, err := Write(&buf, testStats(), Options{Format: format}); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			sessions, err := ReadSessions(&buf)
+			if err != nil {
+				t.Fatalf("ReadSessions() error = %v", err)
+			}
+			if len(sessions) != 2 || sessions[0].ID != "s1" || len(sessions[1].Questions) != 2 {
+				t.Errorf("unexpected sessions: %+v", sessions)
+			}
+		})
+	}
+}
+
+func TestReadSessions_StatisticsFile(t *testing.T) {
+	data := `{"sessions": [{"id": "a", "timestamp": "2025-01-01T10:00:00Z", "mode": "Addition", "difficulty": "Easy"}]}`
+	sessions, err := ReadSessions(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != "a" {
+		t.Errorf("unexpected sessions: %+v", sessions)
+	}
+}
+
+func TestReadSessions_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", "   "},
+		{"csv", "session_id,timestamp\ns1,2025-01-01"},
+		{"bad jsonl line", "{\"id\": \"a\"}\n{\"id\": "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadSessions(strings.NewReader(tt.data)); err == nil {
+				t.Error("ReadSessions() should fail")
+			}
+		})
+	}
+}
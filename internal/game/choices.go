@@ -1,7 +1,6 @@
 package game
 
 import (
-	"math/rand"
 	"sort"
 )
 
@@ -30,7 +29,7 @@ func GenerateChoices(answer int, difficulty Difficulty) (choices []int, correctI
 	copy(choices[1:], distractors)
 
 	// Shuffle and find correct index
-	rand.Shuffle(len(choices), func(i, j int) {
+	rng.Shuffle(len(choices), func(i, j int) {
 		choices[i], choices[j] = choices[j], choices[i]
 	})
 
@@ -117,10 +116,10 @@ func generateDistractor(answer int, difficulty Difficulty) int {
 	var offset int
 
 	if absAnswer < smallAnswerThreshold {
-		offset = rand.Intn(smallAnswerMaxOffset) + 1
+		offset = rng.Intn(smallAnswerMaxOffset) + 1
 	} else {
 		percentRange := maxOffsetPercent - minOffsetPercent + 1
-		percentage := float64(rand.Intn(percentRange)+minOffsetPercent) / 100.0
+		percentage := float64(rng.Intn(percentRange)+minOffsetPercent) / 100.0
 		offset = max(1, int(float64(absAnswer)*percentage))
 	}
 
@@ -132,7 +131,7 @@ func generateDistractor(answer int, difficulty Difficulty) int {
 	}
 
 	// Randomly add or subtract
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		return answer + offset
 	}
 	return answer - offset
@@ -1,5 +1,11 @@
 package game
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 // Difficulty represents the difficulty tier for question generation.
 type Difficulty int
 
@@ -29,26 +35,39 @@ func (d Difficulty) String() string {
 	}
 }
 
+// QuestionTimeLimit returns the per-question countdown for a difficulty,
+// used when the question timer is on.
+func QuestionTimeLimit(d Difficulty) time.Duration {
+	switch d {
+	case Beginner:
+		return 15 * time.Second
+	case Easy:
+		return 10 * time.Second
+	case Medium:
+		return 8 * time.Second
+	case Hard:
+		return 5 * time.Second
+	case Expert:
+		return 4 * time.Second
+	default:
+		return 8 * time.Second
+	}
+}
+
 // AllDifficulties returns all difficulty tiers.
 func AllDifficulties() []Difficulty {
 	return []Difficulty{Beginner, Easy, Medium, Hard, Expert}
 }
 
-// ParseDifficulty converts a string to a Difficulty.
-// Returns Medium as the default for unrecognized strings.
-func ParseDifficulty(s string) Difficulty {
-	switch s {
-	case "Beginner":
-		return Beginner
-	case "Easy":
-		return Easy
-	case "Medium":
-		return Medium
-	case "Hard":
-		return Hard
-	case "Expert":
-		return Expert
-	default:
-		return Medium
+// ParseDifficulty converts a difficulty name to a Difficulty, ignoring case.
+// Unrecognized strings return Medium and an error listing the valid names.
+func ParseDifficulty(s string) (Difficulty, error) {
+	names := make([]string, 0, len(AllDifficulties()))
+	for _, d := range AllDifficulties() {
+		if strings.EqualFold(strings.TrimSpace(s), d.String()) {
+			return d, nil
+		}
+		names = append(names, strings.ToLower(d.String()))
 	}
+	return Medium, fmt.Errorf("unknown difficulty %q (valid: %s)", s, strings.Join(names, ", "))
 }
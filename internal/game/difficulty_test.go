@@ -45,16 +45,28 @@ func TestParseDifficulty(t *testing.T) {
 		{"Medium", Medium},
 		{"Hard", Hard},
 		{"Expert", Expert},
-		{"", Medium},         // default
-		{"invalid", Medium},  // default
-		{"beginner", Medium}, // case sensitive
+		{"beginner", Beginner}, // case insensitive
+		{"EXPERT", Expert},
+		{" hard ", Hard},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			if got := ParseDifficulty(tt.input); got != tt.expect {
+			got, err := ParseDifficulty(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDifficulty(%q) error = %v", tt.input, err)
+			}
+			if got != tt.expect {
 				t.Errorf("ParseDifficulty(%q) = %v, want %v", tt.input, got, tt.expect)
 			}
 		})
 	}
 }
+
+func TestParseDifficultyInvalid(t *testing.T) {
+	for _, input := range []string{"", "invalid", "medum"} {
+		if _, err := ParseDifficulty(input); err == nil {
+			t.Errorf("ParseDifficulty(%q) should fail", input)
+		}
+	}
+}
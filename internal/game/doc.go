@@ -12,6 +12,7 @@
 //   - [Question]: A generated problem with expression tree, answer, and display string
 //   - [QuestionPool]: Batch pre-generation with session-level deduplication
 //   - [Session]: Tracks state during active gameplay with timer, score, and streak
+//   - [Ghost]: Score progression of an earlier session to race against
 //   - [Difficulty]: Skill level (Beginner, Easy, Medium, Hard, Expert)
 //   - [Category]: Mode grouping (basic, power, advanced)
 //
@@ -39,6 +40,11 @@
 // based on Question.Key. When exhausted, it refills with fresh questions while
 // maintaining the session-level dedup cache to prevent repeats.
 //
+// # Randomness
+//
+// Generators, pools and choices draw from the shared generator returned by
+// [Rand]. [Seed] makes the next questions reproducible, e.g. for worksheets.
+//
 // # Sessions
 //
 // A [Session] manages the flow of a timed game:
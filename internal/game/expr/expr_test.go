@@ -658,3 +658,150 @@ func TestExprInterface(t *testing.T) {
 		_ = n.Key()
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Renderer tests
+// ---------------------------------------------------------------------------
+
+func TestRender_Accessible(t *testing.T) {
+	tests := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{"sub", &BinOp{Op: OpSub, Left: &Num{10}, Right: &Num{4}}, "10 - 4"},
+		{"mul", &BinOp{Op: OpMul, Left: &Num{6}, Right: &Num{7}}, "6 * 7"},
+		{"div", &BinOp{Op: OpDiv, Left: &Num{15}, Right: &Num{3}}, "15 / 3"},
+		{"mod", &BinOp{Op: OpMod, Left: &Num{10}, Right: &Num{3}}, "10 mod 3"},
+		{"pct", &BinOp{Op: OpPct, Left: &Num{25}, Right: &Num{80}}, "25 % of 80"},
+		{"sqrt", &UnaryPrefix{Op: OpSqrt, Operand: &Num{49}}, "square root of 49"},
+		{"cbrt", &UnaryPrefix{Op: OpCbrt, Operand: &Num{27}}, "cube root of 27"},
+		{"square", &UnarySuffix{Op: OpSquare, Operand: &Num{7}}, "7 squared"},
+		{"cube", &UnarySuffix{Op: OpCube, Operand: &Num{3}}, "3 cubed"},
+		{"factorial", &UnarySuffix{Op: OpFactorial, Operand: &Num{5}}, "5 factorial"},
+		{"pow", &Pow{Base: &Num{2}, Exp: &Num{10}}, "2 to the power of 10"},
+		{
+			"parens kept",
+			&BinOp{
+				Op:    OpSub,
+				Left:  &BinOp{Op: OpMul, Left: &Num{2}, Right: &BinOp{Op: OpAdd, Left: &Num{5}, Right: &Num{3}}},
+				Right: &BinOp{Op: OpSub, Left: &Num{3}, Right: &Num{1}},
+			},
+			"2 * (5 + 3) - (3 - 1)",
+		},
+		{
+			"unary in binop",
+			&BinOp{Op: OpAdd, Left: &UnaryPrefix{Op: OpSqrt, Operand: &Num{49}}, Right: &UnarySuffix{Op: OpSquare, Operand: &Num{3}}},
+			"square root of 49 + 3 squared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.expr, Accessible); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender_UnicodeMatchesFormat(t *testing.T) {
+	e := &BinOp{
+		Op:    OpDiv,
+		Left:  &Paren{Inner: &BinOp{Op: OpAdd, Left: &Pow{Base: &Num{2}, Exp: &Num{3}}, Right: &Num{4}}},
+		Right: &BinOp{Op: OpDiv, Left: &UnaryPrefix{Op: OpSqrt, Operand: &Num{36}}, Right: &Num{2}},
+	}
+	want := "(2³ + 4) ÷ (√36 ÷ 2)"
+	if got := Render(e, Unicode); got != want || e.Format() != want {
+		t.Errorf("Render = %q, Format = %q, want %q", got, e.Format(), want)
+	}
+}
+
+func TestRender_Notations(t *testing.T) {
+	// 2 × (5 + 3) − (3 − 1)
+	grouped := &BinOp{
+		Op:    OpSub,
+		Left:  &BinOp{Op: OpMul, Left: &Num{2}, Right: &BinOp{Op: OpAdd, Left: &Num{5}, Right: &Num{3}}},
+		Right: &BinOp{Op: OpSub, Left: &Num{3}, Right: &Num{1}},
+	}
+	// √(9 + 16) ÷ 5
+	compoundRoot := &BinOp{
+		Op:    OpDiv,
+		Left:  &UnaryPrefix{Op: OpSqrt, Operand: &Paren{Inner: &BinOp{Op: OpAdd, Left: &Num{9}, Right: &Num{16}}}},
+		Right: &Num{5},
+	}
+	// (2 + 1)³ + 2¹⁰
+	compoundPow := &BinOp{
+		Op:    OpAdd,
+		Left:  &UnarySuffix{Op: OpCube, Operand: &BinOp{Op: OpAdd, Left: &Num{2}, Right: &Num{1}}},
+		Right: &Pow{Base: &Num{2}, Exp: &Num{10}},
+	}
+	// 25 % of 80 mod 7, ∛27 × 5!
+	misc := &BinOp{
+		Op:    OpMod,
+		Left:  &BinOp{Op: OpPct, Left: &Num{25}, Right: &Num{80}},
+		Right: &Num{7},
+	}
+	roots := &BinOp{
+		Op:    OpMul,
+		Left:  &UnaryPrefix{Op: OpCbrt, Operand: &Num{27}},
+		Right: &UnarySuffix{Op: OpFactorial, Operand: &Num{5}},
+	}
+
+	tests := []struct {
+		name     string
+		renderer Renderer
+		want     []string // grouped, compoundRoot, compoundPow, misc, roots
+	}{
+		{"unicode", Unicode, []string{
+			"2 × (5 + 3) − (3 − 1)", "√(9 + 16) ÷ 5", "(2 + 1)³ + 2¹⁰", "25 % of 80 mod 7", "∛27 × 5!",
+		}},
+		{"ascii", ASCII, []string{
+			"2 * (5 + 3) - (3 - 1)", "sqrt(9 + 16) / 5", "(2 + 1)^3 + 2^10", "25 % of 80 mod 7", "cbrt(27) * 5!",
+		}},
+		{"latex", LaTeX, []string{
+			`2 \times \left(5 + 3\right) - \left(3 - 1\right)`,
+			`\sqrt{9 + 16} \div 5`,
+			`\left(2 + 1\right)^{3} + 2^{10}`,
+			`25\% \text{ of } 80 \bmod 7`,
+			`\sqrt[3]{27} \times 5!`,
+		}},
+		{"spoken", Spoken, []string{
+			"2 times open paren 5 plus 3 close paren minus open paren 3 minus 1 close paren",
+			"the square root of open paren 9 plus 16 close paren divided by 5",
+			"open paren 2 plus 1 close paren cubed plus 2 to the power of 10",
+			"25 percent of 80 mod 7",
+			"the cube root of 27 times 5 factorial",
+		}},
+	}
+	exprs := []Expr{grouped, compoundRoot, compoundPow, misc, roots}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, e := range exprs {
+				if got := Render(e, tt.renderer); got != tt.want[i] {
+					t.Errorf("Render(%s) = %q, want %q", e.Key(), got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRender_SpokenNegative(t *testing.T) {
+	e := &BinOp{Op: OpAdd, Left: &Num{-7}, Right: &Num{3}}
+	if got := Render(e, Spoken); got != "negative 7 plus 3" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range Notations() {
+		if _, err := Lookup(name); err != nil {
+			t.Errorf("Lookup(%q) error = %v", name, err)
+		}
+	}
+	if r, err := Lookup(""); err != nil || r != Unicode {
+		t.Errorf("Lookup(\"\") = %v, %v; want Unicode", r, err)
+	}
+	if _, err := Lookup("braille"); err == nil {
+		t.Error("Lookup(braille) should fail")
+	}
+}
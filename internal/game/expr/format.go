@@ -1,39 +1,130 @@
 package expr
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
-// superscript maps digits to their Unicode superscript equivalents.
-var superscript = map[rune]rune{
-	'0': '⁰', '1': '¹', '2': '²', '3': '³', '4': '⁴',
-	'5': '⁵', '6': '⁶', '7': '⁷', '8': '⁸', '9': '⁹',
+// Renderer writes the parts of an expression as text. [Render] walks the
+// tree and decides where parentheses are needed, so every renderer groups
+// an expression the same way and only chooses the notation.
+//
+// The operand of a unary operator or power is compound when it is a
+// binary operation. It is passed without parentheses, and the renderer
+// groups it in its own notation: (9 + 16)², sqrt(9 + 16), \sqrt{9 + 16}.
+type Renderer interface {
+	Num(value int) string
+	BinOp(op BinOpKind, left, right string) string
+	Paren(inner string) string
+	Prefix(op UnaryOp, operand string, compound bool) string
+	Suffix(op UnaryOp, operand string, compound bool) string
+	Pow(base string, compound bool, exp int) string
 }
 
-// toSuperscript converts a non-negative integer to superscript digits.
-func toSuperscript(n int) string {
-	s := fmt.Sprintf("%d", n)
-	result := make([]rune, len(s))
-	for i, c := range s {
-		if sup, ok := superscript[c]; ok {
-			result[i] = sup
-		} else {
-			result[i] = c
+// Notation names, as used by the notation setting.
+const (
+	NotationUnicode = "unicode"
+	NotationASCII   = "ascii"
+	NotationLaTeX   = "latex"
+	NotationSpoken  = "spoken"
+)
+
+// notations lists the selectable renderers in picker order.
+var notations = []struct {
+	name     string
+	renderer Renderer
+}{
+	{NotationUnicode, Unicode},
+	{NotationASCII, ASCII},
+	{NotationLaTeX, LaTeX},
+	{NotationSpoken, Spoken},
+}
+
+// Notations returns the names of the selectable renderers.
+func Notations() []string {
+	names := make([]string, len(notations))
+	for i, n := range notations {
+		names[i] = n.name
+	}
+	return names
+}
+
+// Lookup returns the renderer for a notation name. An empty name selects
+// [Unicode].
+func Lookup(name string) (Renderer, error) {
+	if name == "" {
+		name = NotationUnicode
+	}
+	for _, n := range notations {
+		if n.name == name {
+			return n.renderer, nil
 		}
 	}
-	return string(result)
+	return nil, fmt.Errorf("unknown notation %q (valid: %s)", name, strings.Join(Notations(), ", "))
 }
 
-func (n *Num) Format() string {
-	return fmt.Sprintf("%d", n.Value)
+// current is the renderer questions are displayed with.
+var current = Unicode
+
+// Current returns the renderer questions are displayed with.
+func Current() Renderer {
+	return current
 }
 
-func (b *BinOp) Format() string {
-	left := b.formatChild(b.Left, true)
-	right := b.formatChild(b.Right, false)
-	return left + " " + b.Op.Symbol() + " " + right
+// Use makes r the renderer questions are displayed with.
+func Use(r Renderer) {
+	if r != nil {
+		current = r
+	}
 }
 
+// Apply makes the named notation current. If the name is unknown, Unicode
+// is used and the error is returned.
+func Apply(name string) error {
+	r, err := Lookup(name)
+	if err != nil {
+		Use(Unicode)
+		return err
+	}
+	Use(r)
+	return nil
+}
+
+// Render formats e with r.
+func Render(e Expr, r Renderer) string {
+	switch n := e.(type) {
+	case *Num:
+		return r.Num(n.Value)
+	case *BinOp:
+		left := n.formatChild(n.Left, true, r)
+		right := n.formatChild(n.Right, false, r)
+		return r.BinOp(n.Op, left, right)
+	case *Paren:
+		return r.Paren(Render(n.Inner, r))
+	case *UnaryPrefix:
+		operand, compound := renderOperand(n.Operand, r)
+		return r.Prefix(n.Op, operand, compound)
+	case *UnarySuffix:
+		operand, compound := renderOperand(n.Operand, r)
+		return r.Suffix(n.Op, operand, compound)
+	case *Pow:
+		base, compound := renderOperand(n.Base, r)
+		return r.Pow(base, compound, n.Exp.Eval())
+	default:
+		return e.Format()
+	}
+}
+
+// Format renders with [Unicode], whatever the current renderer is.
+func (n *Num) Format() string         { return Render(n, Unicode) }
+func (b *BinOp) Format() string       { return Render(b, Unicode) }
+func (p *Paren) Format() string       { return Render(p, Unicode) }
+func (u *UnaryPrefix) Format() string { return Render(u, Unicode) }
+func (u *UnarySuffix) Format() string { return Render(u, Unicode) }
+func (p *Pow) Format() string         { return Render(p, Unicode) }
+
 // formatChild wraps a child expression in parens if PEMDAS requires it.
-func (b *BinOp) formatChild(child Expr, isLeft bool) string {
+func (b *BinOp) formatChild(child Expr, isLeft bool, r Renderer) string {
 	switch c := child.(type) {
 	case *BinOp:
 		needsParens := false
@@ -46,45 +137,21 @@ func (b *BinOp) formatChild(child Expr, isLeft bool) string {
 			needsParens = true
 		}
 		if needsParens {
-			return "(" + c.Format() + ")"
+			return r.Paren(Render(c, r))
 		}
-		return c.Format()
+		return Render(c, r)
 	default:
-		return child.Format()
+		return Render(child, r)
 	}
 }
 
-func (p *Paren) Format() string {
-	return "(" + p.Inner.Format() + ")"
-}
-
-func (u *UnaryPrefix) Format() string {
-	switch u.Op {
-	case OpSqrt:
-		return "√" + u.Operand.Format()
-	case OpCbrt:
-		return "∛" + u.Operand.Format()
-	default:
-		return u.Operand.Format()
+// renderOperand renders the operand of a unary operator or power and
+// reports whether it is compound. Explicit parens around the operand are
+// dropped; the renderer adds its own.
+func renderOperand(e Expr, r Renderer) (string, bool) {
+	if p, ok := e.(*Paren); ok {
+		e = p.Inner
 	}
-}
-
-func (u *UnarySuffix) Format() string {
-	val := u.Operand.Format()
-	switch u.Op {
-	case OpSquare:
-		return val + "²"
-	case OpCube:
-		return val + "³"
-	case OpFactorial:
-		return val + "!"
-	default:
-		return val
-	}
-}
-
-func (p *Pow) Format() string {
-	base := p.Base.Format()
-	exp := p.Exp.Eval()
-	return base + toSuperscript(exp)
+	_, compound := e.(*BinOp)
+	return Render(e, r), compound
 }
@@ -0,0 +1,319 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Built-in renderers.
+var (
+	// Unicode is the standard notation: 6 × 7, √49, 2³.
+	Unicode Renderer = unicodeRenderer{}
+	// ASCII uses only ASCII characters: 6 * 7, sqrt(49), 2^3.
+	ASCII Renderer = asciiRenderer{}
+	// LaTeX writes math-mode LaTeX: 6 \times 7, \sqrt{49}, 2^{3}.
+	LaTeX Renderer = latexRenderer{}
+	// Spoken writes expressions as read aloud: 6 times 7, the square root
+	// of 49, 2 to the power of 3.
+	Spoken Renderer = spokenRenderer{}
+	// Accessible uses ASCII operators and spells out unary operators
+	// (square root of 49, 2 to the power of 3) for screen readers.
+	Accessible Renderer = accessibleRenderer{}
+)
+
+// superscript maps digits to their Unicode superscript equivalents.
+var superscript = map[rune]rune{
+	'0': '⁰', '1': '¹', '2': '²', '3': '³', '4': '⁴',
+	'5': '⁵', '6': '⁶', '7': '⁷', '8': '⁸', '9': '⁹',
+}
+
+// toSuperscript converts a non-negative integer to superscript digits.
+func toSuperscript(n int) string {
+	s := fmt.Sprintf("%d", n)
+	result := make([]rune, len(s))
+	for i, c := range s {
+		if sup, ok := superscript[c]; ok {
+			result[i] = sup
+		} else {
+			result[i] = c
+		}
+	}
+	return string(result)
+}
+
+// group wraps a compound operand in r's parentheses.
+func group(r Renderer, operand string, compound bool) string {
+	if compound {
+		return r.Paren(operand)
+	}
+	return operand
+}
+
+type unicodeRenderer struct{}
+
+func (unicodeRenderer) Num(value int) string {
+	return strconv.Itoa(value)
+}
+
+func (unicodeRenderer) BinOp(op BinOpKind, left, right string) string {
+	return left + " " + op.Symbol() + " " + right
+}
+
+func (unicodeRenderer) Paren(inner string) string {
+	return "(" + inner + ")"
+}
+
+func (r unicodeRenderer) Prefix(op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSqrt:
+		return "√" + operand
+	case OpCbrt:
+		return "∛" + operand
+	default:
+		return operand
+	}
+}
+
+func (r unicodeRenderer) Suffix(op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSquare:
+		return operand + "²"
+	case OpCube:
+		return operand + "³"
+	case OpFactorial:
+		return operand + "!"
+	default:
+		return operand
+	}
+}
+
+func (r unicodeRenderer) Pow(base string, compound bool, exp int) string {
+	return group(r, base, compound) + toSuperscript(exp)
+}
+
+type asciiRenderer struct{}
+
+func (asciiRenderer) Num(value int) string {
+	return strconv.Itoa(value)
+}
+
+// asciiSymbol is the ASCII form of a binary operator. The dedup key
+// symbols are used where they read as the operator (% stays "mod").
+func asciiSymbol(op BinOpKind) string {
+	switch op {
+	case OpMod, OpPct:
+		return op.Symbol()
+	default:
+		return op.KeySymbol()
+	}
+}
+
+func (asciiRenderer) BinOp(op BinOpKind, left, right string) string {
+	return left + " " + asciiSymbol(op) + " " + right
+}
+
+func (asciiRenderer) Paren(inner string) string {
+	return "(" + inner + ")"
+}
+
+func (r asciiRenderer) Prefix(op UnaryOp, operand string, compound bool) string {
+	// The function call groups the operand itself
+	switch op {
+	case OpSqrt:
+		return "sqrt(" + operand + ")"
+	case OpCbrt:
+		return "cbrt(" + operand + ")"
+	default:
+		return group(r, operand, compound)
+	}
+}
+
+func (r asciiRenderer) Suffix(op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSquare:
+		return operand + "^2"
+	case OpCube:
+		return operand + "^3"
+	case OpFactorial:
+		return operand + "!"
+	default:
+		return operand
+	}
+}
+
+func (r asciiRenderer) Pow(base string, compound bool, exp int) string {
+	return group(r, base, compound) + "^" + strconv.Itoa(exp)
+}
+
+type latexRenderer struct{}
+
+func (latexRenderer) Num(value int) string {
+	return strconv.Itoa(value)
+}
+
+func (latexRenderer) BinOp(op BinOpKind, left, right string) string {
+	var symbol string
+	switch op {
+	case OpSub:
+		symbol = "-"
+	case OpMul:
+		symbol = `\times`
+	case OpDiv:
+		symbol = `\div`
+	case OpMod:
+		symbol = `\bmod`
+	case OpPct:
+		// "25\% \text{ of } 80"
+		return left + `\% \text{ of } ` + right
+	default:
+		symbol = op.Symbol()
+	}
+	return left + " " + symbol + " " + right
+}
+
+func (latexRenderer) Paren(inner string) string {
+	return `\left(` + inner + `\right)`
+}
+
+func (r latexRenderer) Prefix(op UnaryOp, operand string, compound bool) string {
+	// The radical's braces group the operand itself
+	switch op {
+	case OpSqrt:
+		return `\sqrt{` + operand + `}`
+	case OpCbrt:
+		return `\sqrt[3]{` + operand + `}`
+	default:
+		return group(r, operand, compound)
+	}
+}
+
+func (r latexRenderer) Suffix(op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSquare:
+		return operand + "^{2}"
+	case OpCube:
+		return operand + "^{3}"
+	case OpFactorial:
+		return operand + "!"
+	default:
+		return operand
+	}
+}
+
+func (r latexRenderer) Pow(base string, compound bool, exp int) string {
+	return fmt.Sprintf("%s^{%d}", group(r, base, compound), exp)
+}
+
+type spokenRenderer struct{}
+
+func (spokenRenderer) Num(value int) string {
+	if value < 0 {
+		return "negative " + strconv.Itoa(-value)
+	}
+	return strconv.Itoa(value)
+}
+
+func (spokenRenderer) BinOp(op BinOpKind, left, right string) string {
+	var word string
+	switch op {
+	case OpAdd:
+		word = "plus"
+	case OpSub:
+		word = "minus"
+	case OpMul:
+		word = "times"
+	case OpDiv:
+		word = "divided by"
+	case OpMod:
+		word = "mod"
+	case OpPct:
+		word = "percent of"
+	default:
+		word = op.Symbol()
+	}
+	return left + " " + word + " " + right
+}
+
+// Paren is read out, so grouping survives being spoken.
+func (spokenRenderer) Paren(inner string) string {
+	return "open paren " + inner + " close paren"
+}
+
+// Prefix adds "the", which reads naturally aloud; the accessible renderer
+// keeps the shorter form.
+func (r spokenRenderer) Prefix(op UnaryOp, operand string, compound bool) string {
+	return "the " + spelledPrefix(r, op, operand, compound)
+}
+
+func (r spokenRenderer) Suffix(op UnaryOp, operand string, compound bool) string {
+	return spelledSuffix(r, op, operand, compound)
+}
+
+func (r spokenRenderer) Pow(base string, compound bool, exp int) string {
+	return spelledPow(r, base, compound, exp)
+}
+
+// spelledPrefix, spelledSuffix and spelledPow write unary operators as
+// words, grouping compound operands with r's parentheses. The spoken and
+// accessible renderers share them.
+func spelledPrefix(r Renderer, op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSqrt:
+		return "square root of " + operand
+	case OpCbrt:
+		return "cube root of " + operand
+	default:
+		return operand
+	}
+}
+
+func spelledSuffix(r Renderer, op UnaryOp, operand string, compound bool) string {
+	operand = group(r, operand, compound)
+	switch op {
+	case OpSquare:
+		return operand + " squared"
+	case OpCube:
+		return operand + " cubed"
+	case OpFactorial:
+		return operand + " factorial"
+	default:
+		return operand
+	}
+}
+
+func spelledPow(r Renderer, base string, compound bool, exp int) string {
+	return group(r, base, compound) + " to the power of " + strconv.Itoa(exp)
+}
+
+// accessibleRenderer is the ASCII notation with the spoken renderer's
+// spelled-out unary operators.
+type accessibleRenderer struct{}
+
+func (accessibleRenderer) Num(value int) string {
+	return ASCII.Num(value)
+}
+
+func (accessibleRenderer) BinOp(op BinOpKind, left, right string) string {
+	return ASCII.BinOp(op, left, right)
+}
+
+func (accessibleRenderer) Paren(inner string) string {
+	return ASCII.Paren(inner)
+}
+
+func (r accessibleRenderer) Prefix(op UnaryOp, operand string, compound bool) string {
+	return spelledPrefix(r, op, operand, compound)
+}
+
+func (r accessibleRenderer) Suffix(op UnaryOp, operand string, compound bool) string {
+	return spelledSuffix(r, op, operand, compound)
+}
+
+func (r accessibleRenderer) Pow(base string, compound bool, exp int) string {
+	return spelledPow(r, base, compound, exp)
+}
@@ -1,8 +1,6 @@
 package gen
 
 import (
-	"math/rand"
-
 	"github.com/gurselcakar/arithmego/internal/game"
 )
 
@@ -26,25 +24,25 @@ func (g *AnythingGoesGen) Generate(diff game.Difficulty) *game.Question {
 
 	switch diff {
 	case game.Beginner:
-		picked = singleOpGenerators[rand.Intn(len(singleOpGenerators))]
+		picked = singleOpGenerators[rng.Intn(len(singleOpGenerators))]
 	case game.Easy:
-		if rand.Intn(10) < 7 {
-			picked = singleOpGenerators[rand.Intn(len(singleOpGenerators))]
+		if rng.Intn(10) < 7 {
+			picked = singleOpGenerators[rng.Intn(len(singleOpGenerators))]
 		} else {
-			picked = mixedGenerators[rand.Intn(len(mixedGenerators))]
+			picked = mixedGenerators[rng.Intn(len(mixedGenerators))]
 		}
 	case game.Medium:
-		r := rand.Intn(10)
+		r := rng.Intn(10)
 		if r < 4 {
 			picked = &MixedBasicsGen{}
 		} else if r < 7 {
-			picked = singleOpGenerators[rand.Intn(len(singleOpGenerators))]
+			picked = singleOpGenerators[rng.Intn(len(singleOpGenerators))]
 		} else {
 			mixed := []game.Generator{&MixedPowersGen{}, &MixedAdvancedGen{}}
-			picked = mixed[rand.Intn(len(mixed))]
+			picked = mixed[rng.Intn(len(mixed))]
 		}
 	case game.Hard:
-		r := rand.Intn(4)
+		r := rng.Intn(4)
 		if r < 2 {
 			picked = &MixedBasicsGen{}
 		} else if r < 3 {
@@ -53,7 +51,7 @@ func (g *AnythingGoesGen) Generate(diff game.Difficulty) *game.Question {
 			picked = &MixedAdvancedGen{}
 		}
 	case game.Expert:
-		r := rand.Intn(10)
+		r := rng.Intn(10)
 		if r < 4 {
 			picked = &MixedBasicsGen{}
 		} else if r < 7 {
@@ -61,10 +59,10 @@ func (g *AnythingGoesGen) Generate(diff game.Difficulty) *game.Question {
 		} else if r < 9 {
 			picked = &MixedAdvancedGen{}
 		} else {
-			picked = singleOpGenerators[rand.Intn(len(singleOpGenerators))]
+			picked = singleOpGenerators[rng.Intn(len(singleOpGenerators))]
 		}
 	default:
-		picked = singleOpGenerators[rand.Intn(len(singleOpGenerators))]
+		picked = singleOpGenerators[rng.Intn(len(singleOpGenerators))]
 	}
 
 	q := picked.Generate(diff)
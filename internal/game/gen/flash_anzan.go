@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+)
+
+// FlashLevel sets how hard a flash sequence is: how many digits each
+// number has, how many numbers are flashed, and how long each is shown.
+type FlashLevel struct {
+	Digits   int
+	Count    int
+	Interval time.Duration
+}
+
+// Flash Anzan levels per difficulty.
+var FlashLevels = map[game.Difficulty]FlashLevel{
+	game.Beginner: {1, 3, 1500 * time.Millisecond},
+	game.Easy:     {1, 5, time.Second},
+	game.Medium:   {2, 5, time.Second},
+	game.Hard:     {2, 8, 700 * time.Millisecond},
+	game.Expert:   {3, 10, 500 * time.Millisecond},
+}
+
+// Bounds for the sequence length and flash interval settings.
+const (
+	FlashMinLength   = 2
+	FlashMaxLength   = 30
+	FlashMinInterval = 100 * time.Millisecond
+	FlashMaxInterval = 5 * time.Second
+)
+
+// Player overrides for the sequence length and flash interval. Zero keeps
+// the difficulty's own value.
+var (
+	flashLength   int
+	flashInterval time.Duration
+)
+
+// SetFlashOptions overrides the sequence length and flash interval of
+// Flash Anzan questions. Zero keeps the difficulty's default.
+func SetFlashOptions(length int, interval time.Duration) {
+	flashLength = length
+	flashInterval = interval
+}
+
+type FlashAnzanGen struct{}
+
+func (g *FlashAnzanGen) Label() string { return "Flash Anzan" }
+
+// FlashLevelFor returns the level Flash Anzan questions are generated
+// with at diff, including the player's overrides.
+func FlashLevelFor(diff game.Difficulty) FlashLevel {
+	level, ok := FlashLevels[diff]
+	if !ok {
+		level = FlashLevels[game.Medium]
+	}
+	if flashLength > 0 {
+		level.Count = flashLength
+	}
+	if flashInterval > 0 {
+		level.Interval = flashInterval
+	}
+	return level
+}
+
+func (g *FlashAnzanGen) Generate(diff game.Difficulty) *game.Question {
+	level := FlashLevelFor(diff)
+	numbers := flashNumbers(level.Digits, level.Count)
+
+	// n₁ + n₂ + … + nₖ, so history and retries show the whole sum
+	var e expr.Expr = &expr.Num{Value: numbers[0]}
+	for _, n := range numbers[1:] {
+		e = &expr.BinOp{Op: expr.OpAdd, Left: e, Right: &expr.Num{Value: n}}
+	}
+
+	q := BuildQuestion(e, g.Label())
+	q.Flash = &game.FlashSequence{Numbers: numbers, Interval: level.Interval}
+	return q
+}
+
+// flashNumbers picks count numbers with the given number of digits. A
+// number never repeats the one before it, which would look like a single
+// flash.
+func flashNumbers(digits, count int) []int {
+	lo, hi := 1, IntPow(10, digits)-1
+	if digits > 1 {
+		lo = IntPow(10, digits-1)
+	}
+
+	numbers := make([]int, count)
+	for i := range numbers {
+		n := RandomInRange(lo, hi)
+		for i > 0 && n == numbers[i-1] {
+			n = RandomInRange(lo, hi)
+		}
+		numbers[i] = n
+	}
+	return numbers
+}
@@ -2,7 +2,9 @@ package gen
 
 import (
 	"sort"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/expr"
@@ -229,6 +231,7 @@ func TestRegistryAllGenerators(t *testing.T) {
 		"Mixed Powers",
 		"Mixed Advanced",
 		"Anything Goes",
+		"Flash Anzan",
 	}
 
 	all := All()
@@ -375,6 +378,40 @@ func TestAllGeneratorsSmoke(t *testing.T) {
 	}
 }
 
+func TestFlashAnzanGen(t *testing.T) {
+	g := &FlashAnzanGen{}
+	for diff, level := range FlashLevels {
+		q := g.Generate(diff)
+		if q.Flash == nil {
+			t.Fatalf("Generate(%s) has no flash sequence", diff)
+		}
+		if len(q.Flash.Numbers) != level.Count || q.Flash.Interval != level.Interval {
+			t.Errorf("Generate(%s) flashes %d numbers at %v, want %d at %v",
+				diff, len(q.Flash.Numbers), q.Flash.Interval, level.Count, level.Interval)
+		}
+		sum := 0
+		for i, n := range q.Flash.Numbers {
+			if len(strconv.Itoa(n)) != level.Digits {
+				t.Errorf("Generate(%s) number %d has %d digits, want %d", diff, n, len(strconv.Itoa(n)), level.Digits)
+			}
+			if i > 0 && n == q.Flash.Numbers[i-1] {
+				t.Errorf("Generate(%s) repeats %d back to back", diff, n)
+			}
+			sum += n
+		}
+		if q.Answer != sum {
+			t.Errorf("Generate(%s) answer = %d, want the total %d", diff, q.Answer, sum)
+		}
+	}
+
+	SetFlashOptions(12, 300*time.Millisecond)
+	defer SetFlashOptions(0, 0)
+	q := g.Generate(game.Easy)
+	if len(q.Flash.Numbers) != 12 || q.Flash.Interval != 300*time.Millisecond {
+		t.Errorf("with options, flashes %d numbers at %v, want 12 at 300ms", len(q.Flash.Numbers), q.Flash.Interval)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // TryGenerate test
 // ---------------------------------------------------------------------------
@@ -10,11 +10,12 @@ import (
 //   Label() string
 
 // BuildQuestion creates a Question from an expression tree and label.
+// The question is displayed with the current renderer (see [expr.Use]).
 func BuildQuestion(e expr.Expr, label string) *game.Question {
 	return &game.Question{
 		Expression: e,
 		Answer:     e.Eval(),
-		Display:    e.Format(),
+		Display:    expr.Render(e, expr.Current()),
 		Key:        e.Key(),
 		OpLabel:    label,
 	}
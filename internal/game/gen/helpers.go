@@ -1,6 +1,9 @@
 package gen
 
-import "math/rand"
+import "github.com/gurselcakar/arithmego/internal/game"
+
+// rng is the shared question generator, seedable with game.Seed.
+var rng = game.Rand()
 
 // RandomInRange returns a random integer in [min, max].
 func RandomInRange(min, max int) int {
@@ -10,7 +13,7 @@ func RandomInRange(min, max int) int {
 	if min == max {
 		return min
 	}
-	return min + rand.Intn(max-min+1)
+	return min + rng.Intn(max-min+1)
 }
 
 // IntPow computes base^exp for non-negative integer exponents.
@@ -82,5 +85,5 @@ func AlignToCleanDivision(value, percent, fallback int) int {
 
 // PickFrom selects a random element from a slice.
 func PickFrom(choices []int) int {
-	return choices[rand.Intn(len(choices))]
+	return choices[rng.Intn(len(choices))]
 }
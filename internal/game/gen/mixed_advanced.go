@@ -1,8 +1,6 @@
 package gen
 
 import (
-	"math/rand"
-
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/expr"
 )
@@ -42,7 +40,7 @@ func maSingleRandom(diff game.Difficulty) (expr.Expr, bool) {
 	generators := []func(game.Difficulty) (expr.Expr, bool){
 		modSingle, factSingle, pctSingle, powSingle,
 	}
-	return generators[rand.Intn(len(generators))](diff)
+	return generators[rng.Intn(len(generators))](diff)
 }
 
 // maSimpleComposite: simple combo like n! + m
@@ -62,7 +60,7 @@ func maSimpleComposite(diff game.Difficulty) (expr.Expr, bool) {
 
 // maComposite: n! ÷ m!, 2⁴ + 3!, a mod b + c, etc.
 func maComposite(diff game.Difficulty) (expr.Expr, bool) {
-	switch rand.Intn(3) {
+	switch rng.Intn(3) {
 	case 0:
 		return factDivision(diff)
 	case 1:
@@ -105,7 +103,7 @@ func maModPlusConst(diff game.Difficulty) (expr.Expr, bool) {
 
 // maComplex: complex composites for Expert
 func maComplex(diff game.Difficulty) (expr.Expr, bool) {
-	switch rand.Intn(3) {
+	switch rng.Intn(3) {
 	case 0:
 		// n! ÷ m! + a²
 		divExpr, ok := factDivision(diff)
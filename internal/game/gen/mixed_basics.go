@@ -1,8 +1,6 @@
 package gen
 
 import (
-	"math/rand"
-
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/expr"
 )
@@ -79,7 +77,7 @@ func smallMulOperand(diff game.Difficulty) int {
 
 // randomAddSub returns either OpAdd or OpSub randomly.
 func randomAddSub() expr.BinOpKind {
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		return expr.OpAdd
 	}
 	return expr.OpSub
@@ -96,7 +94,7 @@ func makeSafeDiv(diff game.Difficulty) expr.Expr {
 // mbSingleOp: simple a ○ b (Beginner)
 func mbSingleOp(diff game.Difficulty) (expr.Expr, bool) {
 	ops := []expr.BinOpKind{expr.OpAdd, expr.OpSub, expr.OpMul, expr.OpDiv}
-	op := ops[rand.Intn(len(ops))]
+	op := ops[rng.Intn(len(ops))]
 	if op == expr.OpDiv {
 		return makeSafeDiv(diff), true
 	}
@@ -112,7 +110,7 @@ func mbSingleOp(diff game.Difficulty) (expr.Expr, bool) {
 
 // mbSamePrecedenceChain: a + b − c or a × b × c (same precedence, no PEMDAS needed)
 func mbSamePrecedenceChain(diff game.Difficulty) (expr.Expr, bool) {
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		// Addition/subtraction chain
 		a := operandForDiff(diff)
 		b := operandForDiff(diff)
@@ -143,7 +141,7 @@ func mbParenthesizedMixed(diff game.Difficulty) (expr.Expr, bool) {
 	c := smallMulOperand(diff)
 	addSubOp := randomAddSub()
 
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		// (a + b) × c
 		inner := &expr.Paren{Inner: &expr.BinOp{Op: addSubOp, Left: &expr.Num{Value: a}, Right: &expr.Num{Value: b}}}
 		return &expr.BinOp{Op: expr.OpMul, Left: inner, Right: &expr.Num{Value: c}}, true
@@ -166,7 +164,7 @@ func mbTwoOpPEMDAS(diff game.Difficulty) (expr.Expr, bool) {
 	c := smallMulOperand(diff)
 	addSubOp := randomAddSub()
 
-	if rand.Intn(2) == 0 {
+	if rng.Intn(2) == 0 {
 		// a + b × c
 		return &expr.BinOp{
 			Op:    addSubOp,
@@ -1,8 +1,6 @@
 package gen
 
 import (
-	"math/rand"
-
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/expr"
 )
@@ -39,7 +37,7 @@ var mixedPowersPatterns = PatternSet{
 
 // randomPowerSuffix picks a random power/root unary operation.
 func randomPowerSuffix(n int) expr.Expr {
-	switch rand.Intn(4) {
+	switch rng.Intn(4) {
 	case 0:
 		return &expr.UnarySuffix{Op: expr.OpSquare, Operand: &expr.Num{Value: n}}
 	case 1:
@@ -56,7 +54,7 @@ func mpSingleRandom(diff game.Difficulty) (expr.Expr, bool) {
 	generators := []func(game.Difficulty) (expr.Expr, bool){
 		squareSingle, cubeSingle, sqrtSingle, cbrtSingle,
 	}
-	return generators[rand.Intn(len(generators))](diff)
+	return generators[rng.Intn(len(generators))](diff)
 }
 
 // mpSimpleComposite: n² + m² or √a + √b
@@ -95,7 +93,7 @@ func mpSumDiffMul(diff game.Difficulty) (expr.Expr, bool) {
 	b := randomPowerSuffix(m)
 
 	ops := []expr.BinOpKind{expr.OpAdd, expr.OpSub, expr.OpMul}
-	op := ops[rand.Intn(len(ops))]
+	op := ops[rng.Intn(len(ops))]
 	return &expr.BinOp{Op: op, Left: a, Right: b}, true
 }
 
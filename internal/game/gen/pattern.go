@@ -1,8 +1,6 @@
 package gen
 
 import (
-	"math/rand"
-
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/expr"
 )
@@ -30,7 +28,7 @@ func PickPattern(patterns []WeightedPattern) Pattern {
 		return patterns[0].Pattern
 	}
 
-	r := rand.Intn(total)
+	r := rng.Intn(total)
 	for _, p := range patterns {
 		r -= p.Weight
 		if r < 0 {
@@ -24,6 +24,9 @@ func init() {
 	Register(&MixedPowersGen{})
 	Register(&MixedAdvancedGen{})
 	Register(&AnythingGoesGen{})
+
+	// Flashed sequence generators
+	Register(&FlashAnzanGen{})
 }
 
 // Register adds a generator to the registry.
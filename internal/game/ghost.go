@@ -0,0 +1,41 @@
+package game
+
+import (
+	"sort"
+	"time"
+)
+
+// GhostPoint is the running score of an earlier session after one answer.
+type GhostPoint struct {
+	Offset time.Duration // Game time from session start
+	Score  int
+}
+
+// Ghost replays the score progression of an earlier session so a new
+// session can race against it.
+type Ghost struct {
+	Points []GhostPoint // In order of Offset
+}
+
+// ScoreAt returns the ghost's score after the given game time.
+func (g *Ghost) ScoreAt(elapsed time.Duration) int {
+	if g == nil {
+		return 0
+	}
+	// Index of the first point still ahead of elapsed.
+	i := sort.Search(len(g.Points), func(i int) bool {
+		return g.Points[i].Offset > elapsed
+	})
+	if i == 0 {
+		return 0
+	}
+	return g.Points[i-1].Score
+}
+
+// FinalScore returns the ghost's score at the end of its session.
+func (g *Ghost) FinalScore() int {
+	if g == nil || len(g.Points) == 0 {
+		return 0
+	}
+	return g.Points[len(g.Points)-1].Score
+}
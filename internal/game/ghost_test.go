@@ -0,0 +1,39 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGhostScoreAt(t *testing.T) {
+	g := &Ghost{Points: []GhostPoint{
+		{Offset: 2 * time.Second, Score: 100},
+		{Offset: 5 * time.Second, Score: 100},
+		{Offset: 7 * time.Second, Score: 250},
+	}}
+
+	tests := []struct {
+		elapsed time.Duration
+		want    int
+	}{
+		{0, 0},
+		{time.Second, 0},
+		{2 * time.Second, 100},
+		{6 * time.Second, 100},
+		{7 * time.Second, 250},
+		{time.Minute, 250},
+	}
+	for _, tt := range tests {
+		if got := g.ScoreAt(tt.elapsed); got != tt.want {
+			t.Errorf("ScoreAt(%v) = %d, want %d", tt.elapsed, got, tt.want)
+		}
+	}
+	if got := g.FinalScore(); got != 250 {
+		t.Errorf("FinalScore() = %d, want 250", got)
+	}
+
+	var none *Ghost
+	if none.ScoreAt(time.Minute) != 0 || none.FinalScore() != 0 {
+		t.Error("nil ghost should score 0")
+	}
+}
@@ -1,7 +1,5 @@
 package game
 
-import "math/rand"
-
 const defaultBatchSize = 50
 
 // Generator produces questions for a specific game mode.
@@ -69,7 +67,7 @@ func (p *QuestionPool) fill() {
 	}
 
 	// Shuffle for freshness
-	rand.Shuffle(len(p.questions), func(i, j int) {
+	rng.Shuffle(len(p.questions), func(i, j int) {
 		p.questions[i], p.questions[j] = p.questions[j], p.questions[i]
 	})
 }
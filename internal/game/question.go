@@ -1,6 +1,10 @@
 package game
 
-import "github.com/gurselcakar/arithmego/internal/game/expr"
+import (
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+)
 
 // Question represents a single arithmetic question.
 type Question struct {
@@ -9,6 +13,23 @@ type Question struct {
 	OpLabel    string    // Mode name for statistics: "Addition", "Mixed Basics"
 	Answer     int
 	Display    string
+	Flash      *FlashSequence // Set when the question is flashed one number at a time
+}
+
+// FlashSequence is a run of numbers shown one at a time before the
+// player answers with their total.
+type FlashSequence struct {
+	Numbers  []int
+	Interval time.Duration // How long each number stays on screen
+}
+
+// Format renders the question with r. Questions without an expression
+// tree fall back to Display.
+func (q Question) Format(r expr.Renderer) string {
+	if q.Expression == nil {
+		return q.Display
+	}
+	return expr.Render(q.Expression, r)
 }
 
 // CheckAnswer validates a user's answer.
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// lockedSource is a rand.Source that is safe for concurrent use.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+var (
+	source = &lockedSource{src: rand.NewSource(time.Now().UnixNano()).(rand.Source64)}
+	rng    = rand.New(source)
+)
+
+// Rand returns the random number generator used for questions, pools and
+// choices. It is safe for concurrent use.
+func Rand() *rand.Rand {
+	return rng
+}
+
+// Seed resets the question random number generator so the same seed
+// produces the same questions. Sessions running at the same time share
+// the generator, so only seed when generating on a single goroutine.
+func Seed(seed int64) {
+	source.Seed(seed)
+}
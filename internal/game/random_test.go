@@ -0,0 +1,14 @@
+package game
+
+import "testing"
+
+func TestSeedIsReproducible(t *testing.T) {
+	Seed(99)
+	first := []int{Rand().Intn(1000), Rand().Intn(1000), Rand().Intn(1000)}
+	Seed(99)
+	for i, want := range first {
+		if got := Rand().Intn(1000); got != want {
+			t.Fatalf("draw %d after reseeding = %d, want %d", i, got, want)
+		}
+	}
+}
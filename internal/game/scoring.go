@@ -17,6 +17,17 @@ const (
 	MaxStreakBonus      = 2.0
 	StreakBonusStep     = 0.25
 	StreakMilestoneSize = 5
+
+	// Flash Anzan: points per number in the sequence, with a speed bonus
+	// rising linearly from 1.0x at 1s per number to 2.0x at 250ms
+	FlashPointsPerNumber = 25
+	MaxFlashSpeedBonus   = 2.0
+	FlashBaseInterval    = time.Second
+	FlashFastInterval    = 250 * time.Millisecond
+
+	// Question timer: the bonus rises linearly with the share of the
+	// countdown left, from 1.0x at the buzzer to 2.0x for an instant answer
+	MaxCountdownBonus = 2.0
 )
 
 // StreakTier represents the visual tier of a streak.
@@ -166,13 +177,84 @@ type ScoreResult struct {
 // Points are calculated based on the current streak (before this answer),
 // then the streak is incremented for the next question.
 func CalculateCorrectAnswer(difficulty Difficulty, responseTime time.Duration, currentStreak int) ScoreResult {
+	// Use currentStreak for points - you earn based on streak you had, not streak you'll have
+	points := CalculatePoints(difficulty, responseTime, currentStreak)
+	return correctResult(points, currentStreak)
+}
+
+// CountdownBonus calculates the bonus for answering with left of limit
+// remaining on the question timer. It replaces [TimeBonus] when the timer
+// is on: every moment saved counts, not just answers under 2s.
+func CountdownBonus(left, limit time.Duration) float64 {
+	if limit <= 0 || left <= 0 {
+		return 1.0
+	}
+	if left >= limit {
+		return MaxCountdownBonus
+	}
+	return 1.0 + float64(left)/float64(limit)*(MaxCountdownBonus-1.0)
+}
+
+// CalculateCountdownPoints calculates points for a correct answer given
+// with left of limit remaining on the question timer.
+func CalculateCountdownPoints(difficulty Difficulty, left, limit time.Duration, streak int) int {
+	base := float64(BasePointsCorrect)
+	diffMult := DifficultyMultiplier(difficulty)
+	timeMult := CountdownBonus(left, limit)
+	streakMult := StreakBonus(streak)
+
+	points := base * diffMult * timeMult * streakMult
+	return int(points)
+}
+
+// CalculateCountdownAnswer calculates the score for a correct answer under
+// the question timer, then increments the streak like [CalculateCorrectAnswer].
+func CalculateCountdownAnswer(difficulty Difficulty, left, limit time.Duration, currentStreak int) ScoreResult {
+	points := CalculateCountdownPoints(difficulty, left, limit, currentStreak)
+	return correctResult(points, currentStreak)
+}
+
+// FlashSpeedBonus calculates the speed multiplier for a flash interval.
+// Returns 1.0x at 1s or slower, rising linearly to 2.0x at 250ms or faster.
+func FlashSpeedBonus(interval time.Duration) float64 {
+	if interval >= FlashBaseInterval {
+		return 1.0
+	}
+	if interval <= FlashFastInterval {
+		return MaxFlashSpeedBonus
+	}
+
+	window := FlashBaseInterval - FlashFastInterval
+	gain := float64(FlashBaseInterval-interval) / float64(window) * (MaxFlashSpeedBonus - 1.0)
+	return 1.0 + gain
+}
+
+// CalculateFlashPoints calculates points for a correct flash total.
+// Answer time doesn't count: the sequence length and flash speed set the
+// challenge, so they scale the points instead of the time bonus.
+func CalculateFlashPoints(difficulty Difficulty, count int, interval time.Duration, streak int) int {
+	base := float64(FlashPointsPerNumber * count)
+	diffMult := DifficultyMultiplier(difficulty)
+	speedMult := FlashSpeedBonus(interval)
+	streakMult := StreakBonus(streak)
+
+	points := base * diffMult * speedMult * streakMult
+	return int(points)
+}
+
+// CalculateFlashAnswer calculates the score for a correct flash total,
+// then increments the streak like [CalculateCorrectAnswer].
+func CalculateFlashAnswer(difficulty Difficulty, flash FlashSequence, currentStreak int) ScoreResult {
+	points := CalculateFlashPoints(difficulty, len(flash.Numbers), flash.Interval, currentStreak)
+	return correctResult(points, currentStreak)
+}
+
+// correctResult advances the streak after a correct answer worth points.
+func correctResult(points, currentStreak int) ScoreResult {
 	oldTier := GetStreakTier(currentStreak)
 	newStreak := currentStreak + 1
 	newTier := GetStreakTier(newStreak)
 
-	// Use currentStreak for points - you earn based on streak you had, not streak you'll have
-	points := CalculatePoints(difficulty, responseTime, currentStreak)
-
 	// Milestone when hitting 5, 10, 15, or 20 streak (multiplier increases)
 	isMilestone := GetMilestoneAnnouncement(newStreak) != ""
 
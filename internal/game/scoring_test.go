@@ -251,3 +251,84 @@ func TestCalculateSkip(t *testing.T) {
 		t.Errorf("IsMilestone should be false for skip")
 	}
 }
+
+func TestFlashSpeedBonus(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		want     float64
+	}{
+		{2 * time.Second, 1.0},
+		{time.Second, 1.0},
+		{625 * time.Millisecond, 1.5},
+		{250 * time.Millisecond, 2.0},
+		{100 * time.Millisecond, 2.0},
+	}
+
+	for _, tt := range tests {
+		got := FlashSpeedBonus(tt.interval)
+		if diff := got - tt.want; diff > 0.001 || diff < -0.001 {
+			t.Errorf("FlashSpeedBonus(%v) = %v, want %v", tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateFlashPoints(t *testing.T) {
+	// 5 numbers × 25 at 1s, Medium, no streak
+	if got := CalculateFlashPoints(Medium, 5, time.Second, 0); got != 125 {
+		t.Errorf("CalculateFlashPoints(Medium, 5, 1s, 0) = %d, want 125", got)
+	}
+	// 10 numbers × 25 × 2.0 (Expert) × 2.0 (250ms) × 1.25 (streak 5)
+	if got := CalculateFlashPoints(Expert, 10, 250*time.Millisecond, 5); got != 1250 {
+		t.Errorf("CalculateFlashPoints(Expert, 10, 250ms, 5) = %d, want 1250", got)
+	}
+}
+
+func TestCalculateFlashAnswer(t *testing.T) {
+	flash := FlashSequence{Numbers: []int{3, 7, 2, 9, 4}, Interval: time.Second}
+	result := CalculateFlashAnswer(Medium, flash, 4)
+	if result.Points != 125 {
+		t.Errorf("Points = %d, want 125", result.Points)
+	}
+	if result.NewStreak != 5 {
+		t.Errorf("NewStreak = %d, want 5", result.NewStreak)
+	}
+	if !result.IsMilestone {
+		t.Errorf("IsMilestone should be true at streak 5")
+	}
+}
+
+func TestCountdownBonus(t *testing.T) {
+	limit := 8 * time.Second
+	tests := []struct {
+		left time.Duration
+		want float64
+	}{
+		{limit, 2.0},
+		{6 * time.Second, 1.75},
+		{4 * time.Second, 1.5},
+		{0, 1.0},
+		{-time.Second, 1.0},
+	}
+
+	for _, tt := range tests {
+		got := CountdownBonus(tt.left, limit)
+		if diff := got - tt.want; diff > 0.001 || diff < -0.001 {
+			t.Errorf("CountdownBonus(%v, %v) = %v, want %v", tt.left, limit, got, tt.want)
+		}
+	}
+
+	if got := CountdownBonus(time.Second, 0); got != 1.0 {
+		t.Errorf("CountdownBonus without a limit = %v, want 1.0", got)
+	}
+}
+
+func TestCalculateCountdownAnswer(t *testing.T) {
+	// 100 × 1.5 (Hard) × 1.5 (half the timer left) × 1.0
+	result := CalculateCountdownAnswer(Hard, 2500*time.Millisecond, 5*time.Second, 0)
+	if result.Points != 225 {
+		t.Errorf("Points = %d, want 225", result.Points)
+	}
+	if result.NewStreak != 1 {
+		t.Errorf("NewStreak = %d, want 1", result.NewStreak)
+	}
+}
@@ -1,17 +1,26 @@
 package game
 
-import "time"
+import (
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+)
 
 // QuestionHistory stores data for a single answered question.
 type QuestionHistory struct {
 	Question      string
+	Key           string    // Canonical form, the same in every notation
+	Expression    expr.Expr // Not stored; lets a retry render the question again
 	Operation     string
 	CorrectAnswer int
 	UserAnswer    int
 	Correct       bool
 	Skipped       bool
+	TimedOut      bool           // Skipped because the question timer ran out
+	Flash         *FlashSequence // Numbers and speed of a flashed question (nil otherwise)
 	ResponseTime  time.Duration
 	PointsEarned  int
+	Offset        time.Duration // Game time from session start to the answer
 }
 
 // Session tracks the state of a single game session.
@@ -26,7 +35,9 @@ type Session struct {
 
 	// Current question
 	Current       *Question
-	QuestionStart time.Time // When current question was shown
+	QuestionStart time.Time     // When current question was shown
+	QuestionLimit time.Duration // Per-question countdown; 0 when the question timer is off
+	pausedAt      time.Time     // When the game was paused; zero while running
 
 	// Results
 	Correct   int
@@ -74,6 +85,16 @@ func (s *Session) Tick() {
 	}
 }
 
+// Elapsed returns the game time since the session started, excluding pauses.
+// For timed sessions it never exceeds the duration.
+func (s *Session) Elapsed() time.Duration {
+	elapsed := time.Since(s.StartTime)
+	if s.Duration > 0 && elapsed > s.Duration {
+		return s.Duration
+	}
+	return elapsed
+}
+
 // IsFinished returns true if the session time has expired.
 func (s *Session) IsFinished() bool {
 	return s.TimeLeft <= 0
@@ -88,6 +109,13 @@ func (s *Session) NextQuestion() {
 	}
 }
 
+// StartAnswer restarts the response clock for the current question. The
+// game calls it when a flashed sequence ends, so the flash itself doesn't
+// count as answer time.
+func (s *Session) StartAnswer() {
+	s.QuestionStart = time.Now()
+}
+
 // operationLabel extracts the operation name from the current question.
 func (s *Session) operationLabel() string {
 	if s.Current == nil {
@@ -109,7 +137,14 @@ func (s *Session) SubmitAnswer(answer int) bool {
 	var points int
 	if result.Correct {
 		s.Correct++
-		scoreResult := CalculateCorrectAnswer(s.Difficulty, responseTime, s.Streak)
+		var scoreResult ScoreResult
+		if s.Current.Flash != nil {
+			scoreResult = CalculateFlashAnswer(s.Difficulty, *s.Current.Flash, s.Streak)
+		} else if s.QuestionLimit > 0 {
+			scoreResult = CalculateCountdownAnswer(s.Difficulty, s.QuestionLimit-responseTime, s.QuestionLimit, s.Streak)
+		} else {
+			scoreResult = CalculateCorrectAnswer(s.Difficulty, responseTime, s.Streak)
+		}
 		s.Score += scoreResult.Points
 		s.Streak = scoreResult.NewStreak
 		if s.Streak > s.BestStreak {
@@ -134,13 +169,17 @@ func (s *Session) SubmitAnswer(answer int) bool {
 	// Record question history
 	s.History = append(s.History, QuestionHistory{
 		Question:      s.Current.Display,
+		Key:           s.Current.Key,
+		Expression:    s.Current.Expression,
 		Operation:     s.operationLabel(),
 		CorrectAnswer: s.Current.Answer,
 		UserAnswer:    answer,
 		Correct:       result.Correct,
 		Skipped:       false,
+		Flash:         s.Current.Flash,
 		ResponseTime:  responseTime,
 		PointsEarned:  points,
+		Offset:        s.Elapsed(),
 	})
 
 	s.NextQuestion()
@@ -149,17 +188,50 @@ func (s *Session) SubmitAnswer(answer int) bool {
 
 // Skip skips the current question without answering.
 func (s *Session) Skip() {
+	s.skip(false)
+}
+
+// TimeOut skips the current question because the question timer ran out.
+func (s *Session) TimeOut() {
+	s.skip(true)
+}
+
+// QuestionTimeLeft returns how long is left on the question timer, or 0
+// when the timer is off.
+func (s *Session) QuestionTimeLeft() time.Duration {
+	if s.QuestionLimit <= 0 {
+		return 0
+	}
+	now := time.Now()
+	if !s.pausedAt.IsZero() {
+		now = s.pausedAt
+	}
+	return max(s.QuestionLimit-now.Sub(s.QuestionStart), 0)
+}
+
+// QuestionExpired reports whether the question timer has run out.
+func (s *Session) QuestionExpired() bool {
+	return s.QuestionLimit > 0 && s.QuestionTimeLeft() == 0
+}
+
+// skip records the current question as skipped and moves to the next.
+func (s *Session) skip(timedOut bool) {
 	// Record skipped question before moving to next
 	if s.Current != nil {
 		s.History = append(s.History, QuestionHistory{
 			Question:      s.Current.Display,
+			Key:           s.Current.Key,
+			Expression:    s.Current.Expression,
 			Operation:     s.operationLabel(),
 			CorrectAnswer: s.Current.Answer,
 			UserAnswer:    0,
 			Correct:       false,
 			Skipped:       true,
+			TimedOut:      timedOut,
+			Flash:         s.Current.Flash,
 			ResponseTime:  time.Since(s.QuestionStart),
 			PointsEarned:  0,
+			Offset:        s.Elapsed(),
 		})
 	}
 
@@ -171,10 +243,20 @@ func (s *Session) Skip() {
 	s.NextQuestion()
 }
 
+// Pause stops the question clock until [Session.Resume], so time spent
+// paused counts neither as answer time nor against the question timer.
+func (s *Session) Pause() {
+	s.pausedAt = time.Now()
+}
+
 // Resume restarts the session timer after a pause.
 // It adjusts StartTime so that elapsed time calculations remain correct.
 func (s *Session) Resume() {
 	s.StartTime = time.Now().Add(-(s.Duration - s.TimeLeft))
+	if !s.pausedAt.IsZero() {
+		s.QuestionStart = s.QuestionStart.Add(time.Since(s.pausedAt))
+		s.pausedAt = time.Time{}
+	}
 }
 
 // Accuracy returns the accuracy percentage (0-100).
@@ -240,3 +322,23 @@ func (s *Session) FastestResponseTime() time.Duration {
 	}
 	return fastest
 }
+
+// Mistakes returns the questions answered wrong or skipped, in the order
+// they were shown. A question missed more than once is returned once.
+func (s *Session) Mistakes() []Question {
+	var mistakes []Question
+	seen := make(map[string]bool)
+	for _, h := range s.History {
+		if h.Correct || seen[h.Question] {
+			continue
+		}
+		seen[h.Question] = true
+		mistakes = append(mistakes, Question{
+			Expression: h.Expression,
+			OpLabel:    h.Operation,
+			Answer:     h.CorrectAnswer,
+			Display:    h.Question,
+		})
+	}
+	return mistakes
+}
@@ -129,3 +129,115 @@ func TestSessionIsFinished(t *testing.T) {
 		t.Error("session should be finished after time expires")
 	}
 }
+
+type countingGenerator struct {
+	counter int
+}
+
+func (g *countingGenerator) Generate(diff Difficulty) *Question {
+	g.counter++
+	return &Question{
+		Key:     fmt.Sprintf("count-%d", g.counter),
+		OpLabel: "Addition",
+		Answer:  g.counter + 1,
+		Display: fmt.Sprintf("%d + 1", g.counter),
+	}
+}
+
+func (g *countingGenerator) Label() string { return "Addition" }
+
+func TestSessionMistakes(t *testing.T) {
+	s := NewSession(&countingGenerator{}, Medium, 60*time.Second)
+	s.Start()
+
+	s.SubmitAnswer(s.Current.Answer) // correct
+	s.SubmitAnswer(-1)               // incorrect
+	s.Skip()
+
+	mistakes := s.Mistakes()
+	if len(mistakes) != 2 {
+		t.Fatalf("got %d mistakes, want 2", len(mistakes))
+	}
+	for i, q := range mistakes {
+		h := s.History[i+1]
+		if q.Display != h.Question || !q.CheckAnswer(h.CorrectAnswer).Correct {
+			t.Errorf("mistake %d = %+v, want %q = %d", i, q, h.Question, h.CorrectAnswer)
+		}
+	}
+}
+
+// flashGenerator flashes 1, 2, 3 at one second each.
+type flashGenerator struct{}
+
+func (flashGenerator) Generate(diff Difficulty) *Question {
+	return &Question{
+		Key:     "flash",
+		OpLabel: "Flash",
+		Answer:  6,
+		Display: "1 + 2 + 3",
+		Flash:   &FlashSequence{Numbers: []int{1, 2, 3}, Interval: time.Second},
+	}
+}
+
+func (flashGenerator) Label() string { return "Flash" }
+
+func TestSessionSubmitFlashAnswer(t *testing.T) {
+	s := NewSession(flashGenerator{}, Medium, 60*time.Second)
+	s.Start()
+
+	// Scored by sequence, not response time
+	s.QuestionStart = time.Now().Add(-30 * time.Second)
+	if !s.SubmitAnswer(6) {
+		t.Fatal("answer 6 should be correct")
+	}
+	want := CalculateFlashPoints(Medium, 3, time.Second, 0)
+	if s.Score != want {
+		t.Errorf("score = %d, want %d", s.Score, want)
+	}
+}
+
+func TestSessionTimeOut(t *testing.T) {
+	s := NewSession(&mockGenerator{}, Hard, 60*time.Second)
+	s.QuestionLimit = QuestionTimeLimit(Hard)
+	s.Start()
+
+	if s.QuestionExpired() {
+		t.Fatal("a new question should not have expired")
+	}
+	s.QuestionStart = time.Now().Add(-s.QuestionLimit)
+	if !s.QuestionExpired() || s.QuestionTimeLeft() != 0 {
+		t.Fatalf("QuestionTimeLeft() = %v, want expired", s.QuestionTimeLeft())
+	}
+
+	s.TimeOut()
+	if s.Skipped != 1 {
+		t.Errorf("skipped count should be 1, got %d", s.Skipped)
+	}
+	if h := s.History[0]; !h.Skipped || !h.TimedOut {
+		t.Errorf("history = %+v, want skipped by timeout", h)
+	}
+
+	s.Skip()
+	if s.History[1].TimedOut {
+		t.Error("a skip should not be marked as a timeout")
+	}
+}
+
+func TestSessionPauseStopsQuestionTimer(t *testing.T) {
+	s := NewSession(&mockGenerator{}, Hard, 60*time.Second)
+	s.QuestionLimit = 5 * time.Second
+	s.Start()
+
+	// Shown 2s before a pause that began a minute ago
+	s.Pause()
+	s.pausedAt = s.pausedAt.Add(-time.Minute)
+	s.QuestionStart = s.pausedAt.Add(-2 * time.Second)
+	if got := s.QuestionTimeLeft(); got != 3*time.Second {
+		t.Errorf("QuestionTimeLeft() while paused = %v, want 3s", got)
+	}
+
+	s.Resume()
+	if got := s.QuestionTimeLeft(); got < 2900*time.Millisecond || got > 3*time.Second {
+		t.Errorf("QuestionTimeLeft() after resume = %v, want about 3s", got)
+	}
+}
@@ -0,0 +1,17 @@
+// Package history turns finished game sessions into statistics records.
+//
+// Both the TUI and the headless quiz command record sessions the same way:
+//
+//	record, err := history.NewRecord(session, mode.Name, duration)
+//	if err == nil {
+//	    err = history.Save(record)
+//	}
+//
+// [Save] writes to the storage backend selected in the active profile's config.
+// It also adds the record to the cached summary used by the status command.
+//
+// [LoadGhost] finds the best earlier session with the same mode, difficulty,
+// duration and question timer (and, for Flash Anzan, the same sequence
+// length and speed) and turns it into a [game.Ghost] for the game screen to
+// race.
+package history
@@ -0,0 +1,86 @@
+package history
+
+import (
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// FlashSetup is how many numbers a Flash Anzan session flashed per
+// question and how fast. It is zero for sessions of other modes.
+type FlashSetup struct {
+	Length   int
+	Interval time.Duration
+}
+
+// RecordFlashSetup returns the flash setup a session was played with,
+// taken from its first flashed question.
+func RecordFlashSetup(record storage.SessionRecord) FlashSetup {
+	for _, q := range record.Questions {
+		if q.FlashLength > 0 {
+			return FlashSetup{Length: q.FlashLength, Interval: time.Duration(q.FlashIntervalMs) * time.Millisecond}
+		}
+	}
+	return FlashSetup{}
+}
+
+// FindBest returns the highest-scoring session with the same mode,
+// difficulty, duration, flash setup and question limit (0 with the question
+// timer off, which scores differently). Sessions without question records
+// cannot be replayed, and sessions without points are not worth racing;
+// both are ignored. Ties go to the earlier session.
+func FindBest(stats *storage.Statistics, modeName string, diff game.Difficulty, duration time.Duration, flash FlashSetup, limit time.Duration) (storage.SessionRecord, bool) {
+	var best storage.SessionRecord
+	found := false
+	for _, s := range stats.Sessions {
+		if s.Mode != modeName || s.Difficulty != diff.String() || s.DurationSeconds != int(duration.Seconds()) {
+			continue
+		}
+		if RecordFlashSetup(s) != flash || s.QuestionLimitMs != limit.Milliseconds() {
+			continue
+		}
+		if len(s.Questions) == 0 || s.Score <= 0 {
+			continue
+		}
+		if !found || s.Score > best.Score {
+			best = s
+			found = true
+		}
+	}
+	return best, found
+}
+
+// NewGhost builds the score progression of a recorded session.
+func NewGhost(record storage.SessionRecord) *game.Ghost {
+	offsets := record.AnswerOffsets()
+	ghost := &game.Ghost{Points: make([]game.GhostPoint, 0, len(record.Questions))}
+	var score int
+	for i, q := range record.Questions {
+		score += q.PointsEarned
+		ghost.Points = append(ghost.Points, game.GhostPoint{Offset: offsets[i], Score: score})
+	}
+	return ghost
+}
+
+// LoadGhost returns the ghost of the best earlier session with the same
+// mode, difficulty, duration, flash setup and question limit, or nil if
+// there is none.
+func LoadGhost(modeName string, diff game.Difficulty, duration time.Duration, flash FlashSetup, limit time.Duration) (*game.Ghost, error) {
+	backend, err := storage.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer backend.Close()
+
+	stats, err := backend.Query(storage.SessionQuery{Mode: modeName, Difficulty: diff.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	best, ok := FindBest(stats, modeName, diff, duration, flash, limit)
+	if !ok {
+		return nil, nil
+	}
+	return NewGhost(best), nil
+}
@@ -0,0 +1,73 @@
+package history
+
+import (
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/status"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// NewRecord builds a statistics record from a finished session.
+// modeName is the display name stored in the record (e.g. "Addition").
+func NewRecord(s *game.Session, modeName string, duration time.Duration) (storage.SessionRecord, error) {
+	record, err := storage.NewSessionRecord(modeName, s.Difficulty.String(), int(duration.Seconds()))
+	if err != nil {
+		return storage.SessionRecord{}, err
+	}
+
+	record.QuestionsAttempted = s.TotalAnswered() + s.Skipped
+	record.QuestionsCorrect = s.Correct
+	record.QuestionsWrong = s.Incorrect
+	record.QuestionsSkipped = s.Skipped
+	record.Score = s.Score
+	record.BestStreak = s.BestStreak
+	record.AvgResponseTimeMs = s.AvgResponseTime().Milliseconds()
+	record.QuestionLimitMs = s.QuestionLimit.Milliseconds()
+
+	for _, h := range s.History {
+		q := storage.QuestionRecord{
+			Question:       h.Question,
+			Key:            h.Key,
+			Operation:      h.Operation,
+			CorrectAnswer:  h.CorrectAnswer,
+			UserAnswer:     h.UserAnswer,
+			Correct:        h.Correct,
+			Skipped:        h.Skipped,
+			TimedOut:       h.TimedOut,
+			ResponseTimeMs: h.ResponseTime.Milliseconds(),
+			PointsEarned:   h.PointsEarned,
+			OffsetMs:       h.Offset.Milliseconds(),
+		}
+		if h.Flash != nil {
+			q.FlashLength = len(h.Flash.Numbers)
+			q.FlashIntervalMs = h.Flash.Interval.Milliseconds()
+		}
+		record.Questions = append(record.Questions, q)
+	}
+
+	return record, nil
+}
+
+// Save stores a record in the configured storage backend and updates the
+// status line summary.
+func Save(record storage.SessionRecord) error {
+	// Keep the status line summary current without reparsing the history.
+	// The stamps are taken with the backend closed so they cover everything
+	// it writes.
+	before := status.CurrentStamp()
+	backend, err := storage.Open()
+	if err != nil {
+		return err
+	}
+
+	err = backend.AddSession(record)
+	if closeErr := backend.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	status.Update(before, record)
+	return nil
+}
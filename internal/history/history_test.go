@@ -0,0 +1,163 @@
+package history
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+type fixedGen struct{ n int }
+
+func (g *fixedGen) Generate(diff game.Difficulty) *game.Question {
+	g.n++
+	return &game.Question{Key: fmt.Sprint(g.n), OpLabel: "Addition", Answer: 3, Display: "1 + 2"}
+}
+
+func (g *fixedGen) Label() string { return "Addition" }
+
+func TestNewRecordAndSave(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	s := game.NewSession(&fixedGen{}, game.Hard, time.Minute)
+	s.QuestionLimit = 5 * time.Second
+	s.Start()
+	s.SubmitAnswer(3)
+	s.SubmitAnswer(4)
+	s.Skip()
+	s.TimeOut()
+
+	record, err := NewRecord(s, "Addition", time.Minute)
+	if err != nil {
+		t.Fatalf("NewRecord() error = %v", err)
+	}
+	if record.Difficulty != "Hard" || record.DurationSeconds != 60 || record.QuestionLimitMs != 5000 {
+		t.Errorf("record = %s/%ds/%dms limit, want Hard/60s/5000ms", record.Difficulty, record.DurationSeconds, record.QuestionLimitMs)
+	}
+	if record.QuestionsAttempted != 4 || record.QuestionsCorrect != 1 || record.QuestionsWrong != 1 || record.QuestionsSkipped != 2 {
+		t.Errorf("counts = %d attempted, %d/%d/%d", record.QuestionsAttempted,
+			record.QuestionsCorrect, record.QuestionsWrong, record.QuestionsSkipped)
+	}
+	if len(record.Questions) != 4 || !record.Questions[2].Skipped || record.Questions[2].TimedOut || !record.Questions[3].TimedOut {
+		t.Errorf("questions = %+v", record.Questions)
+	}
+	if record.Questions[0].Key == "" || record.Questions[2].Key == "" {
+		t.Errorf("questions without a key: %+v", record.Questions)
+	}
+	for i := 1; i < len(record.Questions); i++ {
+		if record.Questions[i].OffsetMs < record.Questions[i-1].OffsetMs {
+			t.Errorf("offsets not in order: %+v", record.Questions)
+		}
+	}
+
+	if err := Save(record); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	stats, err := storage.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Sessions) != 1 || stats.Sessions[0].ID != record.ID {
+		t.Errorf("saved sessions = %+v", stats.Sessions)
+	}
+}
+
+func TestFindBestAndNewGhost(t *testing.T) {
+	q := func(offsetMs, responseMs int64, points int) storage.QuestionRecord {
+		return storage.QuestionRecord{OffsetMs: offsetMs, ResponseTimeMs: responseMs, PointsEarned: points}
+	}
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{
+		{ID: "a", Mode: "Addition", Difficulty: "Easy", DurationSeconds: 60, Score: 300,
+			Questions: []storage.QuestionRecord{q(2000, 1500, 100), q(5000, 2000, 200)}},
+		{ID: "b", Mode: "Addition", Difficulty: "Easy", DurationSeconds: 90, Score: 900,
+			Questions: []storage.QuestionRecord{q(1000, 1000, 900)}},
+		{ID: "c", Mode: "Addition", Difficulty: "Hard", DurationSeconds: 60, Score: 900,
+			Questions: []storage.QuestionRecord{q(1000, 1000, 900)}},
+		{ID: "d", Mode: "Addition", Difficulty: "Easy", DurationSeconds: 60, Score: 800},
+		{ID: "e", Mode: "Addition", Difficulty: "Easy", DurationSeconds: 60, Score: 300,
+			Questions: []storage.QuestionRecord{q(0, 1000, 300)}},
+		{ID: "f", Mode: "Addition", Difficulty: "Easy", DurationSeconds: 60, Score: 900, QuestionLimitMs: 5000,
+			Questions: []storage.QuestionRecord{q(1000, 1000, 900)}},
+	}}
+
+	best, ok := FindBest(stats, "Addition", game.Easy, time.Minute, FlashSetup{}, 0)
+	if !ok || best.ID != "a" {
+		t.Fatalf("FindBest() = %q, %v; want a", best.ID, ok)
+	}
+	if _, ok := FindBest(stats, "Subtraction", game.Easy, time.Minute, FlashSetup{}, 0); ok {
+		t.Error("FindBest() found a session for another mode")
+	}
+	// Question timer runs only race each other
+	if timed, ok := FindBest(stats, "Addition", game.Easy, time.Minute, FlashSetup{}, 5*time.Second); !ok || timed.ID != "f" {
+		t.Errorf("FindBest(timer) = %q, %v; want f", timed.ID, ok)
+	}
+
+	ghost := NewGhost(best)
+	if got := ghost.ScoreAt(3 * time.Second); got != 100 {
+		t.Errorf("ScoreAt(3s) = %d, want 100", got)
+	}
+	if got := ghost.FinalScore(); got != 300 {
+		t.Errorf("FinalScore() = %d, want 300", got)
+	}
+
+	// Without offsets, response times are added up: 1.5s, then 3.5s.
+	old := best
+	old.Questions = []storage.QuestionRecord{q(0, 1500, 100), q(0, 2000, 200)}
+	ghost = NewGhost(old)
+	if got := ghost.ScoreAt(3 * time.Second); got != 100 {
+		t.Errorf("fallback ScoreAt(3s) = %d, want 100", got)
+	}
+	if got := ghost.ScoreAt(4 * time.Second); got != 300 {
+		t.Errorf("fallback ScoreAt(4s) = %d, want 300", got)
+	}
+}
+
+type flashGen struct{ n int }
+
+func (g *flashGen) Generate(diff game.Difficulty) *game.Question {
+	g.n++
+	return &game.Question{Key: fmt.Sprint(g.n), OpLabel: "Flash Anzan", Answer: 13, Display: "4 + 7 + 2",
+		Flash: &game.FlashSequence{Numbers: []int{4, 7, 2}, Interval: 700 * time.Millisecond}}
+}
+
+func (g *flashGen) Label() string { return "Flash Anzan" }
+
+func TestFlashSetup(t *testing.T) {
+	s := game.NewSession(&flashGen{}, game.Hard, time.Minute)
+	s.Start()
+	s.SubmitAnswer(13)
+	s.Skip()
+
+	record, err := NewRecord(s, "Flash Anzan", time.Minute)
+	if err != nil {
+		t.Fatalf("NewRecord() error = %v", err)
+	}
+	for _, q := range record.Questions {
+		if q.FlashLength != 3 || q.FlashIntervalMs != 700 {
+			t.Errorf("question flash = %d × %dms, want 3 × 700ms", q.FlashLength, q.FlashIntervalMs)
+		}
+	}
+	want := FlashSetup{Length: 3, Interval: 700 * time.Millisecond}
+	if got := RecordFlashSetup(record); got != want {
+		t.Errorf("RecordFlashSetup() = %+v, want %+v", got, want)
+	}
+
+	// Only a run with the same length and speed is raced.
+	record.Score = 100
+	slow := record
+	slow.ID = "slow"
+	slow.Score = 900
+	slow.Questions = []storage.QuestionRecord{{FlashLength: 3, FlashIntervalMs: 1500, PointsEarned: 900}}
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{slow, record}}
+
+	best, ok := FindBest(stats, "Flash Anzan", game.Hard, time.Minute, want, 0)
+	if !ok || best.ID != record.ID {
+		t.Errorf("FindBest() = %q, %v; want the 700ms run", best.ID, ok)
+	}
+	if _, ok := FindBest(stats, "Flash Anzan", game.Hard, time.Minute, FlashSetup{Length: 30, Interval: 100 * time.Millisecond}, 0); ok {
+		t.Error("FindBest() matched a run with another flash setup")
+	}
+}
@@ -3,15 +3,18 @@
 // A Mode represents a playable game configuration that maps to a question
 // generator via GeneratorLabel. Each mode specifies default difficulty and
 // duration settings. Modes are organized into categories (Sprint for
-// single-operation modes, Challenge for mixed modes) for UI grouping.
+// single-operation modes, Challenge for mixed and flash modes) and into
+// groups ([GroupOrder]: Basics, Powers, Advanced, Mixed, Flash) under
+// which the play screen and the CLI list them.
 //
-// The package provides 16 built-in modes:
+// The package provides 17 built-in modes:
 //   - 4 Basic: Addition, Subtraction, Multiplication, Division
 //   - 4 Power: Squares, Cubes, Square Roots, Cube Roots
 //   - 4 Advanced: Exponents, Remainders, Percentages, Factorials
 //   - 4 Mixed: Mixed Basics, Mixed Powers, Mixed Advanced, Anything Goes
+//   - 1 Flash: Flash Anzan
 //
 // Use [Get] to retrieve a mode by ID, [All] to list all registered modes,
-// and [Register] to add custom modes. [RegisterPresets] registers all built-in
+// [ByGroup] to list the modes of one group, and [Register] to add custom modes. [RegisterPresets] registers all built-in
 // modes and must be called after generators are registered in game/gen.
 package modes
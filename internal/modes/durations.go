@@ -1,6 +1,11 @@
 package modes
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Duration represents a selectable game duration.
 type Duration struct {
@@ -27,3 +32,38 @@ func FindDurationIndex(d time.Duration) int {
 	}
 	return 0
 }
+
+// Bounds for durations given outside the allowed list (e.g. from the CLI).
+const (
+	MinDuration = 10 * time.Second
+	MaxDuration = time.Hour
+)
+
+// IsAllowedDuration reports whether d is one of AllowedDurations.
+func IsAllowedDuration(d time.Duration) bool {
+	for _, dur := range AllowedDurations {
+		if dur.Value == d {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDuration parses a session duration such as "45s", "2m" or "90"
+// (plain numbers are seconds). The result must lie within MinDuration
+// and MaxDuration.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		secs, atoiErr := strconv.Atoi(s)
+		if atoiErr != nil {
+			return 0, fmt.Errorf("invalid duration %q (use e.g. 45s or 2m)", s)
+		}
+		d = time.Duration(secs) * time.Second
+	}
+	if d < MinDuration || d > MaxDuration {
+		return 0, fmt.Errorf("duration %s out of range (%s to %s)", d, MinDuration, MaxDuration)
+	}
+	return d, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
 )
 
 // Mode represents a game mode configuration.
@@ -22,8 +23,35 @@ type Mode struct {
 
 	// Category for UI grouping
 	Category ModeCategory
+
+	// Group is the heading the mode is listed under (see GroupOrder)
+	Group string
+}
+
+// Flashed reports whether the mode flashes its numbers one at a time. Only
+// the TUI can play such modes; quiz, serve and worksheets print the whole
+// sequence at once, so they reject them.
+func (m *Mode) Flashed() bool {
+	g, ok := gen.Get(m.GeneratorLabel)
+	if !ok {
+		return false
+	}
+	_, flashed := g.(*gen.FlashAnzanGen)
+	return flashed
 }
 
+// Groups list modes by the kind of operation they practice.
+const (
+	GroupBasics   = "Basics"
+	GroupPowers   = "Powers"
+	GroupAdvanced = "Advanced"
+	GroupMixed    = "Mixed"
+	GroupFlash    = "Flash"
+)
+
+// GroupOrder is the order in which groups are listed.
+var GroupOrder = []string{GroupBasics, GroupPowers, GroupAdvanced, GroupMixed, GroupFlash}
+
 // ModeCategory groups modes in the UI.
 type ModeCategory int
 
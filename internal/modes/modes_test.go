@@ -17,8 +17,15 @@ func init() {
 
 func TestRegisterPresets(t *testing.T) {
 	modes := All()
-	if len(modes) != 16 {
-		t.Errorf("expected 16 preset modes, got %d", len(modes))
+	if len(modes) != 17 {
+		t.Errorf("expected 17 preset modes, got %d", len(modes))
+	}
+}
+
+func TestRegisterPresetsTwice(t *testing.T) {
+	RegisterPresets()
+	if n := len(All()); n != 17 {
+		t.Errorf("expected 17 modes after registering again, got %d", n)
 	}
 }
 
@@ -47,6 +54,8 @@ func TestGetMode(t *testing.T) {
 		{IDMixedPowers, "Mixed Powers"},
 		{IDMixedAdvanced, "Mixed Advanced"},
 		{IDAnythingGoes, "Anything Goes"},
+		// Flash modes
+		{IDFlashAnzan, "Flash Anzan"},
 	}
 
 	for _, tt := range tests {
@@ -148,3 +157,44 @@ func TestAllModesHaveDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Duration
+	}{
+		{"45s", 45 * time.Second},
+		{"2m", 2 * time.Minute},
+		{"90", 90 * time.Second},
+		{"1h", time.Hour},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "5s", "2h", "-30s"} {
+		if _, err := ParseDuration(input); err == nil {
+			t.Errorf("ParseDuration(%q) should fail", input)
+		}
+	}
+}
+
+func TestIsAllowedDuration(t *testing.T) {
+	if !IsAllowedDuration(time.Minute) {
+		t.Error("1m should be allowed")
+	}
+	if IsAllowedDuration(45 * time.Second) {
+		t.Error("45s should not be allowed")
+	}
+}
+
+func TestFlashed(t *testing.T) {
+	for _, m := range All() {
+		if want := m.ID == IDFlashAnzan; m.Flashed() != want {
+			t.Errorf("%s: Flashed() = %v, want %v", m.ID, m.Flashed(), want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package modes
 
 import (
+	"sync"
 	"time"
 
 	"github.com/gurselcakar/arithmego/internal/game"
@@ -34,10 +35,21 @@ const (
 	IDMixedPowers   = "mixed-powers"
 	IDMixedAdvanced = "mixed-advanced"
 	IDAnythingGoes  = "anything-goes"
+
+	// Flash modes
+	IDFlashAnzan = "flash-anzan"
 )
 
-// RegisterPresets registers all built-in modes.
+// presetsOnce makes RegisterPresets safe to call more than once; commands
+// and tests each make sure the presets are there.
+var presetsOnce sync.Once
+
+// RegisterPresets registers all built-in modes. Later calls do nothing.
 func RegisterPresets() {
+	presetsOnce.Do(registerPresets)
+}
+
+func registerPresets() {
 	// Basic operations
 	Register(&Mode{
 		ID:                IDAddition,
@@ -47,6 +59,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupBasics,
 	})
 
 	Register(&Mode{
@@ -57,6 +70,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupBasics,
 	})
 
 	Register(&Mode{
@@ -67,6 +81,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupBasics,
 	})
 
 	Register(&Mode{
@@ -77,6 +92,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupBasics,
 	})
 
 	// Power operations
@@ -88,6 +104,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupPowers,
 	})
 
 	Register(&Mode{
@@ -98,6 +115,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupPowers,
 	})
 
 	Register(&Mode{
@@ -108,6 +126,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupPowers,
 	})
 
 	Register(&Mode{
@@ -118,6 +137,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupPowers,
 	})
 
 	// Advanced operations
@@ -129,6 +149,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupAdvanced,
 	})
 
 	Register(&Mode{
@@ -139,6 +160,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupAdvanced,
 	})
 
 	Register(&Mode{
@@ -149,6 +171,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupAdvanced,
 	})
 
 	Register(&Mode{
@@ -159,6 +182,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategorySprint,
+		Group:             GroupAdvanced,
 	})
 
 	// Mixed modes
@@ -170,6 +194,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategoryChallenge,
+		Group:             GroupMixed,
 	})
 
 	Register(&Mode{
@@ -180,6 +205,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategoryChallenge,
+		Group:             GroupMixed,
 	})
 
 	Register(&Mode{
@@ -190,6 +216,7 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategoryChallenge,
+		Group:             GroupMixed,
 	})
 
 	Register(&Mode{
@@ -200,5 +227,18 @@ func RegisterPresets() {
 		DefaultDifficulty: game.Medium,
 		DefaultDuration:   60 * time.Second,
 		Category:          CategoryChallenge,
+		Group:             GroupMixed,
+	})
+
+	// Flash modes
+	Register(&Mode{
+		ID:                IDFlashAnzan,
+		Name:              "Flash Anzan",
+		Description:       "Add up numbers flashed one at a time",
+		GeneratorLabel:    "Flash Anzan",
+		DefaultDifficulty: game.Medium,
+		DefaultDuration:   2 * time.Minute,
+		Category:          CategoryChallenge,
+		Group:             GroupFlash,
 	})
 }
@@ -23,3 +23,14 @@ func All() []*Mode {
 	}
 	return modes
 }
+
+// ByGroup returns the registered modes in a group, in registration order.
+func ByGroup(group string) []*Mode {
+	var modes []*Mode
+	for _, id := range orderedIDs {
+		if m := registry[id]; m.Group == group {
+			modes = append(modes, m)
+		}
+	}
+	return modes
+}
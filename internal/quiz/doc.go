@@ -0,0 +1,24 @@
+// Package quiz runs game sessions over plain text streams, without the TUI.
+//
+// [Run] drives a [game.Session]: it writes one question per line, reads one
+// answer per line, and scores answers exactly like the TUI. Input ends at
+// EOF, on "q", or after [Options.Count] questions.
+//
+// # Plain text
+//
+//	Addition · Easy · 3 questions
+//	Type an answer and press Enter. 's' skips, 'q' quits.
+//
+//	[1/3] 7 + 5 = ?
+//	  correct +15 (score 15)
+//
+// # JSON lines
+//
+// With [Options.JSON], every output line is one JSON event: [StartEvent],
+// [QuestionEvent], [ResultEvent], [ErrorEvent] or [SummaryEvent], each with a
+// "type" field. Input lines may be plain ("12", "s", "q") or JSON objects:
+//
+//	{"answer": 12}
+//	{"skip": true}
+//	{"quit": true}
+package quiz
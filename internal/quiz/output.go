@@ -0,0 +1,210 @@
+package quiz
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+)
+
+// Event types in the JSON line protocol.
+const (
+	EventStart    = "start"
+	EventQuestion = "question"
+	EventResult   = "result"
+	EventError    = "error"
+	EventSummary  = "summary"
+)
+
+// StartEvent is written once before the first question.
+type StartEvent struct {
+	Type       string `json:"type"`
+	Mode       string `json:"mode"`
+	ModeName   string `json:"mode_name"`
+	Difficulty string `json:"difficulty"`
+	Count      int    `json:"count"`
+}
+
+// QuestionEvent asks the next question.
+type QuestionEvent struct {
+	Type      string `json:"type"`
+	N         int    `json:"n"`
+	Question  string `json:"question"`
+	Operation string `json:"operation"`
+}
+
+// ResultEvent reports the outcome of an answer or skip.
+type ResultEvent struct {
+	Type          string `json:"type"`
+	N             int    `json:"n"`
+	Correct       bool   `json:"correct"`
+	Skipped       bool   `json:"skipped"`
+	Answer        int    `json:"answer"`
+	CorrectAnswer int    `json:"correct_answer"`
+	Points        int    `json:"points"`
+	Score         int    `json:"score"`
+	Streak        int    `json:"streak"`
+	ResponseMs    int64  `json:"response_ms"`
+}
+
+// ErrorEvent reports an input line that could not be parsed.
+// The current question stays open.
+type ErrorEvent struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// SummaryEvent is written once when the quiz ends.
+type SummaryEvent struct {
+	Type          string  `json:"type"`
+	Asked         int     `json:"asked"`
+	Completed     bool    `json:"completed"`
+	Score         int     `json:"score"`
+	Correct       int     `json:"correct"`
+	Wrong         int     `json:"wrong"`
+	Skipped       int     `json:"skipped"`
+	Accuracy      float64 `json:"accuracy"`
+	BestStreak    int     `json:"best_streak"`
+	AvgResponseMs int64   `json:"avg_response_ms"`
+	Saved         bool    `json:"saved"`
+	SessionID     string  `json:"session_id,omitempty"`
+	SaveError     string  `json:"save_error,omitempty"`
+}
+
+// writer renders quiz events as plain text or JSON lines.
+// The first write error is kept in err and later writes are skipped.
+type writer struct {
+	out  io.Writer
+	enc  *json.Encoder
+	json bool
+	err  error
+}
+
+func newWriter(out io.Writer, jsonLines bool) *writer {
+	return &writer{out: out, enc: json.NewEncoder(out), json: jsonLines}
+}
+
+func (w *writer) printf(format string, args ...any) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.out, format, args...)
+	}
+}
+
+func (w *writer) encode(v any) {
+	if w.err == nil {
+		w.err = w.enc.Encode(v)
+	}
+}
+
+func (w *writer) start(opts Options, diff game.Difficulty) {
+	if w.json {
+		w.encode(StartEvent{
+			Type:       EventStart,
+			Mode:       opts.ModeID,
+			ModeName:   opts.ModeName,
+			Difficulty: diff.String(),
+			Count:      opts.Count,
+		})
+		return
+	}
+	count := "until you quit"
+	if opts.Count > 0 {
+		count = fmt.Sprintf("%d questions", opts.Count)
+	}
+	w.printf("%s · %s · %s\n", opts.ModeName, diff, count)
+	w.printf("Type an answer and press Enter. 's' skips, 'q' quits.\n\n")
+}
+
+func (w *writer) question(n, count int, q *game.Question) {
+	if w.json {
+		w.encode(QuestionEvent{Type: EventQuestion, N: n, Question: q.Display, Operation: q.OpLabel})
+		return
+	}
+	if count > 0 {
+		w.printf("[%d/%d] %s = ?\n", n, count, q.Display)
+	} else {
+		w.printf("[%d] %s = ?\n", n, q.Display)
+	}
+}
+
+func (w *writer) invalid(err error) {
+	if w.json {
+		w.encode(ErrorEvent{Type: EventError, Message: err.Error()})
+		return
+	}
+	w.printf("  %s\n", capitalize(err.Error()))
+}
+
+func (w *writer) result(n int, s *game.Session) {
+	h := s.History[len(s.History)-1]
+	if w.json {
+		w.encode(ResultEvent{
+			Type:          EventResult,
+			N:             n,
+			Correct:       h.Correct,
+			Skipped:       h.Skipped,
+			Answer:        h.UserAnswer,
+			CorrectAnswer: h.CorrectAnswer,
+			Points:        h.PointsEarned,
+			Score:         s.Score,
+			Streak:        s.Streak,
+			ResponseMs:    h.ResponseTime.Milliseconds(),
+		})
+		return
+	}
+	switch {
+	case h.Skipped:
+		w.printf("  skipped, answer was %d\n", h.CorrectAnswer)
+	case h.Correct:
+		w.printf("  correct %+d (score %d)\n", h.PointsEarned, s.Score)
+	default:
+		w.printf("  wrong, answer was %d (score %d)\n", h.CorrectAnswer, s.Score)
+	}
+}
+
+func (w *writer) summary(s *game.Session, r Result) {
+	if w.json {
+		ev := SummaryEvent{
+			Type:          EventSummary,
+			Asked:         r.Asked,
+			Completed:     r.Completed,
+			Score:         s.Score,
+			Correct:       s.Correct,
+			Wrong:         s.Incorrect,
+			Skipped:       s.Skipped,
+			Accuracy:      s.Accuracy(),
+			BestStreak:    s.BestStreak,
+			AvgResponseMs: s.AvgResponseTime().Milliseconds(),
+			Saved:         r.SessionID != "",
+			SessionID:     r.SessionID,
+		}
+		if r.SaveErr != nil {
+			ev.SaveError = r.SaveErr.Error()
+		}
+		w.encode(ev)
+		return
+	}
+
+	w.printf("\n")
+	if r.Asked == 0 {
+		w.printf("No questions answered.\n")
+		return
+	}
+	w.printf("Score %d · %d correct · %d wrong · %d skipped · %.0f%% accuracy · best streak %d\n",
+		s.Score, s.Correct, s.Incorrect, s.Skipped, s.Accuracy(), s.BestStreak)
+	switch {
+	case r.SaveErr != nil:
+		w.printf("Could not save session: %v\n", r.SaveErr)
+	case r.SessionID != "":
+		w.printf("Saved to statistics.\n")
+	}
+}
+
+// capitalize upper-cases the first letter of an ASCII message.
+func capitalize(s string) string {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
@@ -0,0 +1,148 @@
+package quiz
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+)
+
+// maxLineSize bounds a single line of input.
+const maxLineSize = 64 * 1024
+
+// Options configures a quiz run.
+type Options struct {
+	ModeID   string // Mode ID reported in the JSON start event
+	ModeName string // Mode name shown in the header
+	Count    int    // Number of questions; 0 asks until EOF or quit
+	JSON     bool   // Use the JSON line protocol instead of plain text
+
+	// Save records the finished session and returns its ID. It is called
+	// only if at least one question was answered or skipped. Nil disables saving.
+	Save func(s *game.Session, elapsed time.Duration) (string, error)
+}
+
+// Result summarizes a finished quiz.
+type Result struct {
+	Asked     int    // Questions answered or skipped
+	Completed bool   // All Count questions were asked
+	SessionID string // ID of the saved record (empty if not saved)
+	SaveErr   error  // Error from Options.Save, if any
+}
+
+// input is one parsed line of player input.
+type input struct {
+	answer int
+	skip   bool
+	quit   bool
+}
+
+// errInvalidInput is returned for lines that are not an answer or command.
+var errInvalidInput = errors.New("enter a number, 's' to skip or 'q' to quit")
+
+// Run asks questions from s, reading answers from in and writing to out,
+// until Count questions were asked, the player quits, or in reaches EOF.
+// The session must not be started; Run starts it.
+func Run(in io.Reader, out io.Writer, s *game.Session, opts Options) (Result, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineSize)
+	w := newWriter(out, opts.JSON)
+
+	var result Result
+	w.start(opts, s.Difficulty)
+	s.Start()
+
+	for opts.Count == 0 || result.Asked < opts.Count {
+		if s.Current == nil {
+			return result, errors.New("no question available for this mode")
+		}
+		w.question(result.Asked+1, opts.Count, s.Current)
+
+		cmd, ok, err := readInput(scanner, w)
+		if err != nil {
+			return result, err
+		}
+		if !ok || cmd.quit {
+			break
+		}
+
+		if cmd.skip {
+			s.Skip()
+		} else {
+			s.SubmitAnswer(cmd.answer)
+		}
+		result.Asked++
+		w.result(result.Asked, s)
+	}
+	result.Completed = opts.Count > 0 && result.Asked >= opts.Count
+
+	if result.Asked > 0 && opts.Save != nil {
+		result.SessionID, result.SaveErr = opts.Save(s, time.Since(s.StartTime))
+	}
+	w.summary(s, result)
+
+	return result, w.err
+}
+
+// readInput reads lines until one parses. Returns ok=false on EOF.
+func readInput(scanner *bufio.Scanner, w *writer) (input, bool, error) {
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		cmd, err := parseInput(line)
+		if err != nil {
+			w.invalid(err)
+			continue
+		}
+		return cmd, true, nil
+	}
+	return input{}, false, scanner.Err()
+}
+
+// jsonInput is an input line in the JSON protocol.
+type jsonInput struct {
+	Answer *int `json:"answer"`
+	Skip   bool `json:"skip"`
+	Quit   bool `json:"quit"`
+}
+
+// parseInput parses a plain answer ("42", "s", "q") or a JSON object
+// ({"answer":42}, {"skip":true}, {"quit":true}).
+func parseInput(line string) (input, error) {
+	if strings.HasPrefix(line, "{") {
+		var in jsonInput
+		if err := json.Unmarshal([]byte(line), &in); err != nil {
+			return input{}, fmt.Errorf("invalid JSON input: %w", err)
+		}
+		switch {
+		case in.Quit:
+			return input{quit: true}, nil
+		case in.Skip:
+			return input{skip: true}, nil
+		case in.Answer != nil:
+			return input{answer: *in.Answer}, nil
+		}
+		return input{}, errors.New(`JSON input needs "answer", "skip" or "quit"`)
+	}
+
+	switch strings.ToLower(line) {
+	case "s", "skip":
+		return input{skip: true}, nil
+	case "q", "quit", "exit":
+		return input{quit: true}, nil
+	}
+
+	n, err := strconv.Atoi(strings.ReplaceAll(line, " ", ""))
+	if err != nil {
+		return input{}, errInvalidInput
+	}
+	return input{answer: n}, nil
+}
@@ -0,0 +1,155 @@
+package quiz
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+)
+
+// fixedGen always asks "1 + 2" with unique keys.
+type fixedGen struct{ n int }
+
+func (g *fixedGen) Generate(diff game.Difficulty) *game.Question {
+	g.n++
+	return &game.Question{Key: fmt.Sprint(g.n), OpLabel: "Addition", Answer: 3, Display: "1 + 2"}
+}
+
+func (g *fixedGen) Label() string { return "Addition" }
+
+func newSession() *game.Session {
+	return game.NewSession(&fixedGen{}, game.Easy, 0)
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    input
+		wantErr bool
+	}{
+		{"42", input{answer: 42}, false},
+		{"-7", input{answer: -7}, false},
+		{"1 000", input{answer: 1000}, false},
+		{"s", input{skip: true}, false},
+		{"SKIP", input{skip: true}, false},
+		{"q", input{quit: true}, false},
+		{`{"answer": 5}`, input{answer: 5}, false},
+		{`{"answer": 0}`, input{answer: 0}, false},
+		{`{"skip": true}`, input{skip: true}, false},
+		{`{"quit": true}`, input{quit: true}, false},
+		{"abc", input{}, true},
+		{`{"foo": 1}`, input{}, true},
+		{`{"answer":`, input{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := parseInput(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInput(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseInput(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_PlainText(t *testing.T) {
+	var out strings.Builder
+	s := newSession()
+
+	result, err := Run(strings.NewReader("3\nnope\n4\ns\n"), &out, s, Options{ModeName: "Addition", Count: 3})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.Asked != 3 || !result.Completed {
+		t.Errorf("Result = %+v, want 3 asked and completed", result)
+	}
+	if s.Correct != 1 || s.Incorrect != 1 || s.Skipped != 1 {
+		t.Errorf("session counts = %d/%d/%d, want 1/1/1", s.Correct, s.Incorrect, s.Skipped)
+	}
+
+	text := out.String()
+	for _, want := range []string{"[1/3] 1 + 2 = ?", "correct", "Enter a number", "wrong, answer was 3", "skipped", "Score "} {
+		if !strings.Contains(text, want) {
+			t.Errorf("output missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestRun_EOF(t *testing.T) {
+	var out strings.Builder
+	s := newSession()
+	saved := false
+
+	result, err := Run(strings.NewReader("3\n"), &out, s, Options{
+		Count: 10,
+		Save: func(s *game.Session, elapsed time.Duration) (string, error) {
+			saved = true
+			return "id-1", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if result.Asked != 1 || result.Completed {
+		t.Errorf("Result = %+v, want 1 asked, not completed", result)
+	}
+	if !saved || result.SessionID != "id-1" {
+		t.Error("session with answers should be saved")
+	}
+}
+
+func TestRun_NothingAnsweredIsNotSaved(t *testing.T) {
+	var out strings.Builder
+	saved := false
+
+	result, err := Run(strings.NewReader("q\n"), &out, newSession(), Options{
+		Save: func(s *game.Session, elapsed time.Duration) (string, error) {
+			saved = true
+			return "id", nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if saved || result.Asked != 0 {
+		t.Errorf("empty quiz should not be saved (asked %d)", result.Asked)
+	}
+	if !strings.Contains(out.String(), "No questions answered") {
+		t.Errorf("output = %q", out.String())
+	}
+}
+
+func TestRun_JSONProtocol(t *testing.T) {
+	var out strings.Builder
+	in := `{"answer": 3}` + "\n" + `{"oops": 1}` + "\n" + `{"skip": true}` + "\n"
+
+	_, err := Run(strings.NewReader(in), &out, newSession(), Options{ModeID: "addition", ModeName: "Addition", Count: 2, JSON: true})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var types []string
+	scanner := bufio.NewScanner(strings.NewReader(out.String()))
+	for scanner.Scan() {
+		var ev struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("output line is not JSON: %q", scanner.Text())
+		}
+		types = append(types, ev.Type)
+	}
+
+	want := []string{EventStart, EventQuestion, EventResult, EventQuestion, EventError, EventResult, EventSummary}
+	if strings.Join(types, ",") != strings.Join(want, ",") {
+		t.Errorf("event types = %v, want %v", types, want)
+	}
+}
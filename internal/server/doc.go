@@ -0,0 +1,37 @@
+// Package server exposes the game engine and statistics over a local
+// HTTP/JSON API, for web pages and editor plugins.
+//
+// Each session is a [game.Session] scored exactly like the TUI. Sessions are
+// kept in memory, locked individually, and saved to statistics through the
+// history package when they finish.
+//
+// # Endpoints
+//
+//	GET    /api/modes                       List game modes
+//	POST   /api/sessions                    Start a session
+//	GET    /api/sessions/{id}               Session state and current question
+//	GET    /api/sessions/{id}/question      Current question only
+//	POST   /api/sessions/{id}/answer        Answer: {"answer": 12} or {"skip": true}
+//	POST   /api/sessions/{id}/skip          Skip the current question
+//	POST   /api/sessions/{id}/end           Finish and save the session
+//	DELETE /api/sessions/{id}               Discard the session without saving
+//	GET    /api/statistics                  Totals (?period=7d&difficulty=&category=&mode=)
+//	GET    /api/statistics/history          Recent sessions (same filters, ?limit=20)
+//
+// A session is started with a body such as
+//
+//	{"mode": "addition", "difficulty": "hard", "duration": "60s"}
+//
+// or {"mode": "division", "count": 20} for a fixed number of questions
+// without a timer. Timed sessions finish on their own once time runs out;
+// the next request sees "finished": true and the saved record ID.
+// Finished sessions stay readable until the server needs their place for a
+// new session (at most [MaxSessions] are held) or they go idle.
+//
+// There is no authentication, so [Server.Handler] only answers requests
+// addressed to the listen address or a loopback host, from browser pages on
+// the same host or on loopback, and with application/json bodies. Other web
+// pages the user has open cannot play sessions or read statistics.
+//
+// Errors are returned as {"error": "..."} with a 4xx or 5xx status.
+package server
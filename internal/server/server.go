@@ -0,0 +1,587 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// Limits on live sessions.
+const (
+	// MaxSessions is the number of sessions kept in memory at once.
+	// Finished sessions give up their place when a new one needs it.
+	MaxSessions = 64
+	// IdleTimeout is how long a session is kept without requests. Idle
+	// sessions are finished (and saved) before they are dropped.
+	IdleTimeout = 30 * time.Minute
+	// maxBodySize bounds request bodies.
+	maxBodySize = 64 * 1024
+	// defaultHistoryLimit is the number of sessions /api/statistics/history returns.
+	defaultHistoryLimit = 20
+)
+
+// defaultDuration is used for sessions started without a duration or count.
+const defaultDuration = 60 * time.Second
+
+// Options configures a Server.
+type Options struct {
+	// Save records a finished session and returns the record ID. It is
+	// called once per session, only if a question was answered or skipped.
+	// Nil disables saving.
+	Save func(s *game.Session, modeName string, elapsed time.Duration) (string, error)
+
+	// Addr is the address the server listens on. Requests must name it or
+	// a loopback host in their Host header; see [Server.Handler].
+	Addr string
+}
+
+// Server holds live sessions and serves the API.
+type Server struct {
+	opts Options
+
+	mu       sync.Mutex
+	sessions map[string]*liveSession
+
+	// saveMu serializes saves; storage backends are not written concurrently.
+	saveMu sync.Mutex
+}
+
+// New creates a server with no sessions.
+func New(opts Options) *Server {
+	return &Server{
+		opts:     opts,
+		sessions: make(map[string]*liveSession),
+	}
+}
+
+// Handler returns the HTTP handler for the API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/modes", s.handleModes)
+	mux.HandleFunc("POST /api/sessions", s.handleCreate)
+	mux.HandleFunc("GET /api/sessions/{id}", s.withSession(s.handleState))
+	mux.HandleFunc("GET /api/sessions/{id}/question", s.withSession(s.handleQuestion))
+	mux.HandleFunc("POST /api/sessions/{id}/answer", s.withSession(s.handleAnswer))
+	mux.HandleFunc("POST /api/sessions/{id}/skip", s.withSession(s.handleSkip))
+	mux.HandleFunc("POST /api/sessions/{id}/end", s.withSession(s.handleEnd))
+	mux.HandleFunc("DELETE /api/sessions/{id}", s.handleDelete)
+	mux.HandleFunc("GET /api/statistics", s.handleStatistics)
+	mux.HandleFunc("GET /api/statistics/history", s.handleHistory)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "no such endpoint")
+	})
+	return s.guard(mux)
+}
+
+// guard keeps other web pages out of the API. The server has no
+// authentication, so a page open in the user's browser could otherwise
+// play sessions into their statistics, or read them through DNS
+// rebinding. Requests must be addressed to the server's own host name,
+// browsers may only call it from a loopback or same-host origin, and
+// request bodies must be JSON, which browsers cannot send cross-origin
+// without asking first.
+func (s *Server) guard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.allowedHost(r.Host) {
+			writeError(w, http.StatusMisdirectedRequest, "unexpected Host header")
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" && !allowedOrigin(origin, r.Host) {
+			writeError(w, http.StatusForbidden, "cross-origin requests are not allowed")
+			return
+		}
+		if r.ContentLength != 0 && r.Body != nil && r.Body != http.NoBody {
+			if mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mt != "application/json" {
+				writeError(w, http.StatusUnsupportedMediaType, "request body must be application/json")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost reports whether host names this server: the listen address
+// or a loopback host on any port.
+func (s *Server) allowedHost(host string) bool {
+	if s.opts.Addr != "" && strings.EqualFold(host, s.opts.Addr) {
+		return true
+	}
+	return isLoopback(hostname(host))
+}
+
+// allowedOrigin reports whether a browser page at origin may call the API
+// reached as host: pages served from the same host or from loopback.
+func allowedOrigin(origin, host string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false // Includes "null" from sandboxed pages and files
+	}
+	return strings.EqualFold(u.Host, host) || isLoopback(u.Hostname())
+}
+
+// hostname strips the port from a Host header value.
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return strings.Trim(host, "[]")
+}
+
+func isLoopback(name string) bool {
+	if strings.EqualFold(name, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(name)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Close finishes and saves every live session. Call it when shutting down.
+func (s *Server) Close() {
+	s.mu.Lock()
+	live := make([]*liveSession, 0, len(s.sessions))
+	for id, ls := range s.sessions {
+		live = append(live, ls)
+		delete(s.sessions, id)
+	}
+	s.mu.Unlock()
+
+	for _, ls := range live {
+		ls.mu.Lock()
+		s.finish(ls)
+		ls.mu.Unlock()
+	}
+}
+
+// createRequest is the body of POST /api/sessions.
+type createRequest struct {
+	Mode       string `json:"mode"`
+	Difficulty string `json:"difficulty"` // Default: the mode's default
+	Duration   string `json:"duration"`   // e.g. "60s"; ignored if Count is set
+	Count      int    `json:"count"`      // Questions to ask; 0 plays for Duration
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var req createRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ls, err := newLiveSession(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.sweep()
+	s.mu.Lock()
+	if !s.makeRoom() {
+		s.mu.Unlock()
+		writeError(w, http.StatusServiceUnavailable, "too many sessions; end or delete one first")
+		return
+	}
+	s.sessions[ls.id] = ls
+	s.mu.Unlock()
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	writeJSON(w, http.StatusCreated, ls.state())
+}
+
+// newLiveSession validates req and starts a session.
+func newLiveSession(req createRequest) (*liveSession, error) {
+	mode, ok := modes.Get(strings.ToLower(req.Mode))
+	if !ok {
+		return nil, fmt.Errorf("unknown mode %q", req.Mode)
+	}
+	if mode.Flashed() {
+		return nil, fmt.Errorf("mode %q flashes its numbers and can only be played in the game", mode.ID)
+	}
+	g, ok := gen.Get(mode.GeneratorLabel)
+	if !ok {
+		return nil, fmt.Errorf("no generator for mode %q", mode.ID)
+	}
+
+	diff := mode.DefaultDifficulty
+	if req.Difficulty != "" {
+		var err error
+		if diff, err = game.ParseDifficulty(req.Difficulty); err != nil {
+			return nil, err
+		}
+	}
+
+	var duration time.Duration
+	switch {
+	case req.Count < 0:
+		return nil, errors.New("count cannot be negative")
+	case req.Count > 0:
+		// Count-based: no timer.
+	case req.Duration != "":
+		var err error
+		if duration, err = modes.ParseDuration(req.Duration); err != nil {
+			return nil, err
+		}
+	default:
+		duration = defaultDuration
+	}
+
+	session := game.NewSession(g, diff, duration)
+	session.Start()
+	if session.Current == nil {
+		return nil, errors.New("no question available for this mode")
+	}
+
+	return &liveSession{
+		id:         uuid.NewString(),
+		mode:       mode,
+		session:    session,
+		count:      req.Count,
+		lastActive: time.Now(),
+	}, nil
+}
+
+// withSession looks up the session in the path, locks it and marks it active.
+// Timed sessions whose time is up are finished before h runs.
+func (s *Server) withSession(h func(w http.ResponseWriter, r *http.Request, ls *liveSession)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		ls, ok := s.sessions[r.PathValue("id")]
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "session not found")
+			return
+		}
+
+		ls.mu.Lock()
+		defer ls.mu.Unlock()
+		ls.lastActive = time.Now()
+		if ls.timeUp() {
+			s.finish(ls)
+		}
+		h(w, r, ls)
+	}
+}
+
+func (s *Server) handleState(w http.ResponseWriter, r *http.Request, ls *liveSession) {
+	writeJSON(w, http.StatusOK, ls.state())
+}
+
+func (s *Server) handleQuestion(w http.ResponseWriter, r *http.Request, ls *liveSession) {
+	if ls.finished {
+		writeError(w, http.StatusConflict, "session is finished")
+		return
+	}
+	writeJSON(w, http.StatusOK, ls.question())
+}
+
+// answerRequest is the body of POST /api/sessions/{id}/answer.
+type answerRequest struct {
+	Answer *int `json:"answer"`
+	Skip   bool `json:"skip"`
+}
+
+func (s *Server) handleAnswer(w http.ResponseWriter, r *http.Request, ls *liveSession) {
+	var req answerRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Answer == nil && !req.Skip {
+		writeError(w, http.StatusBadRequest, `body needs "answer" or "skip"`)
+		return
+	}
+	s.respond(w, ls, req)
+}
+
+func (s *Server) handleSkip(w http.ResponseWriter, r *http.Request, ls *liveSession) {
+	s.respond(w, ls, answerRequest{Skip: true})
+}
+
+// respond applies an answer or skip and writes the result with the new state.
+func (s *Server) respond(w http.ResponseWriter, ls *liveSession, req answerRequest) {
+	if ls.finished {
+		writeError(w, http.StatusConflict, "session is finished")
+		return
+	}
+
+	if req.Skip {
+		ls.session.Skip()
+	} else {
+		ls.session.SubmitAnswer(*req.Answer)
+	}
+	ls.asked++
+	result := ls.lastResult()
+
+	if ls.count > 0 && ls.asked >= ls.count {
+		s.finish(ls)
+	}
+	writeJSON(w, http.StatusOK, answerResponse{Result: result, State: ls.state()})
+}
+
+func (s *Server) handleEnd(w http.ResponseWriter, r *http.Request, ls *liveSession) {
+	s.finish(ls)
+	writeJSON(w, http.StatusOK, ls.state())
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	_, ok := s.sessions[r.PathValue("id")]
+	delete(s.sessions, r.PathValue("id"))
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "session not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// finish ends ls and saves it once. ls.mu must be held.
+func (s *Server) finish(ls *liveSession) {
+	if ls.finished {
+		return
+	}
+	ls.finished = true
+	ls.session.Tick()
+	ls.elapsed = time.Since(ls.session.StartTime)
+	if ls.session.Duration > 0 && ls.elapsed > ls.session.Duration {
+		ls.elapsed = ls.session.Duration
+	}
+
+	if ls.asked == 0 || s.opts.Save == nil {
+		return
+	}
+	s.saveMu.Lock()
+	ls.recordID, ls.saveErr = s.opts.Save(ls.session, ls.mode.Name, ls.elapsed)
+	s.saveMu.Unlock()
+}
+
+// makeRoom drops the least recently used finished session when the server
+// is full, and reports whether a new session fits. Finished sessions are
+// already saved; they are only kept so clients can read the final state.
+// s.mu must be held.
+func (s *Server) makeRoom() bool {
+	if len(s.sessions) < MaxSessions {
+		return true
+	}
+
+	var oldestID string
+	var oldest time.Time
+	for id, ls := range s.sessions {
+		// Busy sessions are in use, so they are not the ones to drop.
+		if !ls.mu.TryLock() {
+			continue
+		}
+		if ls.finished && (oldestID == "" || ls.lastActive.Before(oldest)) {
+			oldestID, oldest = id, ls.lastActive
+		}
+		ls.mu.Unlock()
+	}
+	if oldestID == "" {
+		return false
+	}
+	delete(s.sessions, oldestID)
+	return true
+}
+
+// sweep finishes and drops sessions that have been idle for IdleTimeout.
+func (s *Server) sweep() {
+	cutoff := time.Now().Add(-IdleTimeout)
+
+	s.mu.Lock()
+	var idle []*liveSession
+	for id, ls := range s.sessions {
+		// TryLock skips sessions that are busy, which are not idle anyway.
+		if !ls.mu.TryLock() {
+			continue
+		}
+		if ls.lastActive.Before(cutoff) {
+			idle = append(idle, ls)
+			delete(s.sessions, id)
+		}
+		ls.mu.Unlock()
+	}
+	s.mu.Unlock()
+
+	for _, ls := range idle {
+		ls.mu.Lock()
+		s.finish(ls)
+		ls.mu.Unlock()
+	}
+}
+
+// modeView is one entry of GET /api/modes.
+type modeView struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Category          string `json:"category"`
+	Description       string `json:"description"`
+	DefaultDifficulty string `json:"default_difficulty"`
+}
+
+func (s *Server) handleModes(w http.ResponseWriter, r *http.Request) {
+	// Flashed modes need the game's timing, so the API does not offer them
+	var views []modeView
+	for _, m := range modes.All() {
+		if m.Flashed() {
+			continue
+		}
+		views = append(views, modeView{
+			ID:                m.ID,
+			Name:              m.Name,
+			Category:          m.Category.String(),
+			Description:       m.Description,
+			DefaultDifficulty: m.DefaultDifficulty.String(),
+		})
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+// statisticsView is the body of GET /api/statistics.
+type statisticsView struct {
+	Sessions          int                      `json:"sessions"`
+	Questions         int                      `json:"questions"`
+	Correct           int                      `json:"correct"`
+	Accuracy          float64                  `json:"accuracy"`
+	TotalPoints       int                      `json:"total_points"`
+	BestStreak        int                      `json:"best_streak"`
+	BestScore         int                      `json:"best_score"`
+	AvgResponseMs     int64                    `json:"avg_response_ms"`
+	FastestResponseMs int64                    `json:"fastest_response_ms"`
+	LastPlayedAt      time.Time                `json:"last_played_at,omitzero"`
+	Operations        map[string]operationView `json:"operations"`
+}
+
+type operationView struct {
+	Questions     int     `json:"questions"`
+	Correct       int     `json:"correct"`
+	Accuracy      float64 `json:"accuracy"`
+	AvgResponseMs int64   `json:"avg_response_ms"`
+}
+
+func (s *Server) handleStatistics(w http.ResponseWriter, r *http.Request) {
+	stats, filter, ok := s.queryStatistics(w, r)
+	if !ok {
+		return
+	}
+
+	agg := analytics.ComputeFilteredAggregates(stats, filter)
+	view := statisticsView{
+		Sessions:          agg.TotalSessions,
+		Questions:         agg.TotalQuestions,
+		Correct:           agg.TotalCorrect,
+		Accuracy:          agg.OverallAccuracy,
+		TotalPoints:       agg.TotalPoints,
+		BestStreak:        agg.PersonalBests.BestStreak,
+		BestScore:         agg.PersonalBests.BestScore,
+		AvgResponseMs:     agg.AvgResponseTimeMs,
+		FastestResponseMs: agg.FastestResponseMs,
+		LastPlayedAt:      agg.LastPlayedAt,
+		Operations:        make(map[string]operationView, len(agg.ByOperationExtended)),
+	}
+	for op, o := range agg.ByOperationExtended {
+		view.Operations[op] = operationView{
+			Questions:     o.Total,
+			Correct:       o.Correct,
+			Accuracy:      o.Accuracy,
+			AvgResponseMs: o.AvgResponseTimeMs,
+		}
+	}
+	writeJSON(w, http.StatusOK, view)
+}
+
+func (s *Server) handleHistory(w http.ResponseWriter, r *http.Request) {
+	limit := defaultHistoryLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "limit must be a non-negative number")
+			return
+		}
+		limit = n
+	}
+
+	stats, filter, ok := s.queryStatistics(w, r)
+	if !ok {
+		return
+	}
+
+	sessions := analytics.GetSessionsByFilter(stats, filter)
+	if limit > 0 && len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	writeJSON(w, http.StatusOK, sessions)
+}
+
+// queryStatistics loads the sessions matching the filter query parameters.
+// On failure it writes the error and returns ok=false.
+func (s *Server) queryStatistics(w http.ResponseWriter, r *http.Request) (*storage.Statistics, analytics.AggregateFilter, bool) {
+	q := r.URL.Query()
+	filter, err := analytics.ParseAggregateFilter(q.Get("period"), q.Get("difficulty"), q.Get("category"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, filter, false
+	}
+	if id := q.Get("mode"); id != "" {
+		mode, ok := modes.Get(id)
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown mode %q", id))
+			return nil, filter, false
+		}
+		filter.Mode = mode.Name
+	}
+
+	// Reads wait for in-flight saves so a finished session is visible.
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	backend, err := storage.Open()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return nil, filter, false
+	}
+	defer backend.Close()
+
+	stats, err := backend.Query(filter.SessionQuery())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return nil, filter, false
+	}
+	return stats, filter, true
+}
+
+// decodeBody parses a JSON request body into v. An empty body leaves v unchanged.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
@@ -0,0 +1,374 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/history"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// countingSave records how many sessions were saved.
+type countingSave struct{ n atomic.Int32 }
+
+func (c *countingSave) save(s *game.Session, modeName string, elapsed time.Duration) (string, error) {
+	return fmt.Sprintf("record-%d", c.n.Add(1)), nil
+}
+
+func newTestServer(t *testing.T, opts Options) (*Server, *httptest.Server) {
+	t.Helper()
+	modes.RegisterPresets()
+	srv := New(opts)
+	ts := httptest.NewServer(srv.Handler())
+	t.Cleanup(ts.Close)
+	return srv, ts
+}
+
+// do sends a request and decodes the JSON response into out (if non-nil).
+func do(t *testing.T, method, url, body string, out any) int {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("%s %s: decoding response: %v", method, url, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func createSession(t *testing.T, ts *httptest.Server, body string) StateView {
+	t.Helper()
+	var state StateView
+	if code := do(t, "POST", ts.URL+"/api/sessions", body, &state); code != http.StatusCreated {
+		t.Fatalf("create session: status %d", code)
+	}
+	return state
+}
+
+func TestCountSessionFinishesAndSaves(t *testing.T) {
+	saves := &countingSave{}
+	_, ts := newTestServer(t, Options{Save: saves.save})
+
+	state := createSession(t, ts, `{"mode": "addition", "difficulty": "hard", "count": 2}`)
+	if state.Difficulty != "Hard" || state.Question == nil || state.Question.N != 1 {
+		t.Fatalf("new session state = %+v", state)
+	}
+	base := ts.URL + "/api/sessions/" + state.ID
+
+	var q QuestionView
+	do(t, "GET", base+"/question", "", &q)
+	if q.Question != state.Question.Question {
+		t.Errorf("question = %q, want %q", q.Question, state.Question.Question)
+	}
+
+	var resp answerResponse
+	if code := do(t, "POST", base+"/answer", `{"answer": -1}`, &resp); code != http.StatusOK {
+		t.Fatalf("answer: status %d", code)
+	}
+	if resp.Result.Correct || resp.State.Wrong != 1 || resp.State.Finished {
+		t.Errorf("after wrong answer: %+v", resp)
+	}
+
+	resp = answerResponse{}
+	do(t, "POST", base+"/skip", "", &resp)
+	if !resp.Result.Skipped || !resp.State.Finished || resp.State.Question != nil {
+		t.Errorf("after last question: %+v", resp)
+	}
+	if resp.State.RecordID != "record-1" {
+		t.Errorf("RecordID = %q, want record-1", resp.State.RecordID)
+	}
+
+	if code := do(t, "POST", base+"/answer", `{"answer": 1}`, nil); code != http.StatusConflict {
+		t.Errorf("answer after finish: status %d, want 409", code)
+	}
+	do(t, "POST", base+"/end", "", nil)
+	if n := saves.n.Load(); n != 1 {
+		t.Errorf("saved %d times, want 1", n)
+	}
+}
+
+func TestTimedSessionFinishesWhenTimeIsUp(t *testing.T) {
+	saves := &countingSave{}
+	srv, ts := newTestServer(t, Options{Save: saves.save})
+
+	state := createSession(t, ts, `{"mode": "subtraction", "duration": "30s"}`)
+	if state.DurationMs != 30000 {
+		t.Fatalf("DurationMs = %d, want 30000", state.DurationMs)
+	}
+	base := ts.URL + "/api/sessions/" + state.ID
+	do(t, "POST", base+"/skip", "", nil)
+
+	// Move the session's start into the past instead of waiting.
+	srv.mu.Lock()
+	ls := srv.sessions[state.ID]
+	srv.mu.Unlock()
+	ls.mu.Lock()
+	ls.session.StartTime = time.Now().Add(-time.Minute)
+	ls.mu.Unlock()
+
+	do(t, "GET", base, "", &state)
+	if !state.Finished || state.TimeLeftMs != 0 || state.RecordID == "" {
+		t.Errorf("state after time is up = %+v", state)
+	}
+	if code := do(t, "POST", base+"/skip", "", nil); code != http.StatusConflict {
+		t.Errorf("skip after time is up: status %d, want 409", code)
+	}
+}
+
+func TestRequestErrors(t *testing.T) {
+	_, ts := newTestServer(t, Options{})
+	state := createSession(t, ts, `{"mode": "addition"}`)
+	base := ts.URL + "/api/sessions/" + state.ID
+
+	tests := []struct {
+		method, url, body string
+		want              int
+	}{
+		{"POST", ts.URL + "/api/sessions", `{"mode": "nope"}`, http.StatusBadRequest},
+		{"POST", ts.URL + "/api/sessions", `{"mode": "addition", "difficulty": "medum"}`, http.StatusBadRequest},
+		{"POST", ts.URL + "/api/sessions", `{"mode": "addition", "duration": "1s"}`, http.StatusBadRequest},
+		{"POST", ts.URL + "/api/sessions", `{"mode": "addition", "colour": "red"}`, http.StatusBadRequest},
+		{"POST", ts.URL + "/api/sessions", `{"mode": "flash-anzan"}`, http.StatusBadRequest},
+		{"POST", base + "/answer", `{"answer": "twelve"}`, http.StatusBadRequest},
+		{"POST", base + "/answer", `{}`, http.StatusBadRequest},
+		{"GET", ts.URL + "/api/sessions/missing", "", http.StatusNotFound},
+		{"GET", ts.URL + "/api/statistics?period=3d", "", http.StatusBadRequest},
+		{"GET", ts.URL + "/api/nothing", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		var body map[string]any
+		code := do(t, tt.method, tt.url, tt.body, &body)
+		if code != tt.want {
+			t.Errorf("%s %s %s: status %d, want %d", tt.method, tt.url, tt.body, code, tt.want)
+		}
+		if body["error"] == nil {
+			t.Errorf("%s %s: response has no error message", tt.method, tt.url)
+		}
+	}
+}
+
+func TestModesLeaveOutFlashedModes(t *testing.T) {
+	_, ts := newTestServer(t, Options{})
+
+	var views []modeView
+	if code := do(t, "GET", ts.URL+"/api/modes", "", &views); code != http.StatusOK {
+		t.Fatalf("modes: status %d", code)
+	}
+	if len(views) != len(modes.All())-1 {
+		t.Errorf("got %d modes, want every mode but Flash Anzan", len(views))
+	}
+	for _, v := range views {
+		if v.ID == modes.IDFlashAnzan {
+			t.Error("/api/modes lists flash-anzan")
+		}
+	}
+}
+
+func TestGuardRejectsOtherSites(t *testing.T) {
+	saves := &countingSave{}
+	_, ts := newTestServer(t, Options{Save: saves.save, Addr: "arithmego.test:8080"})
+
+	tests := []struct {
+		name                      string
+		host, origin, contentType string
+		want                      int
+	}{
+		{"same host", "", "", "application/json", http.StatusCreated},
+		{"localhost page", "", "http://localhost:3000", "application/json; charset=utf-8", http.StatusCreated},
+		{"listen address", "arithmego.test:8080", "", "application/json", http.StatusCreated},
+		{"plain text body", "", "", "text/plain", http.StatusUnsupportedMediaType},
+		{"no content type", "", "", "", http.StatusUnsupportedMediaType},
+		{"foreign origin", "", "https://evil.example", "application/json", http.StatusForbidden},
+		{"null origin", "", "null", "application/json", http.StatusForbidden},
+		{"rebound host", "evil.example:8080", "", "application/json", http.StatusMisdirectedRequest},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest("POST", ts.URL+"/api/sessions", strings.NewReader(`{"mode": "addition", "count": 1}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.host != "" {
+			req.Host = tt.host
+		}
+		if tt.origin != "" {
+			req.Header.Set("Origin", tt.origin)
+		}
+		if tt.contentType != "" {
+			req.Header.Set("Content-Type", tt.contentType)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.want {
+			t.Errorf("%s: status %d, want %d", tt.name, resp.StatusCode, tt.want)
+		}
+	}
+
+	// Reads are guarded too, against DNS rebinding.
+	req, _ := http.NewRequest("GET", ts.URL+"/api/statistics", nil)
+	req.Host = "evil.example:8080"
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMisdirectedRequest {
+		t.Errorf("rebound statistics read: status %d, want 421", resp.StatusCode)
+	}
+}
+
+func TestDeleteDiscardsWithoutSaving(t *testing.T) {
+	saves := &countingSave{}
+	_, ts := newTestServer(t, Options{Save: saves.save})
+
+	state := createSession(t, ts, `{"mode": "addition"}`)
+	base := ts.URL + "/api/sessions/" + state.ID
+	do(t, "POST", base+"/skip", "", nil)
+
+	if code := do(t, "DELETE", base, "", nil); code != http.StatusNoContent {
+		t.Errorf("delete: status %d, want 204", code)
+	}
+	if code := do(t, "GET", base, "", nil); code != http.StatusNotFound {
+		t.Errorf("get after delete: status %d, want 404", code)
+	}
+	if saves.n.Load() != 0 {
+		t.Error("deleted session was saved")
+	}
+}
+
+func TestFinishedSessionsFreeTheirSlot(t *testing.T) {
+	saves := &countingSave{}
+	_, ts := newTestServer(t, Options{Save: saves.save})
+
+	// Fill the server with one-question sessions that are played and saved.
+	var first StateView
+	for i := 0; i < MaxSessions; i++ {
+		state := createSession(t, ts, `{"mode": "addition", "count": 1}`)
+		if i == 0 {
+			first = state
+		}
+		do(t, "POST", ts.URL+"/api/sessions/"+state.ID+"/skip", "", nil)
+	}
+
+	next := createSession(t, ts, `{"mode": "addition", "count": 1}`)
+	if code := do(t, "GET", ts.URL+"/api/sessions/"+first.ID, "", nil); code != http.StatusNotFound {
+		t.Errorf("oldest finished session: status %d, want 404 after its slot was reused", code)
+	}
+	if code := do(t, "GET", ts.URL+"/api/sessions/"+next.ID, "", nil); code != http.StatusOK {
+		t.Errorf("new session: status %d, want 200", code)
+	}
+	if n := saves.n.Load(); n != MaxSessions {
+		t.Errorf("saved %d sessions, want %d", n, MaxSessions)
+	}
+}
+
+func TestUnfinishedSessionsKeepTheirSlot(t *testing.T) {
+	_, ts := newTestServer(t, Options{})
+
+	for i := 0; i < MaxSessions; i++ {
+		createSession(t, ts, `{"mode": "addition"}`)
+	}
+	if code := do(t, "POST", ts.URL+"/api/sessions", `{"mode": "addition"}`, nil); code != http.StatusServiceUnavailable {
+		t.Errorf("create on a full server: status %d, want 503", code)
+	}
+}
+
+func TestCloseSavesUnfinishedSessions(t *testing.T) {
+	saves := &countingSave{}
+	srv, ts := newTestServer(t, Options{Save: saves.save})
+
+	answered := createSession(t, ts, `{"mode": "addition"}`)
+	do(t, "POST", ts.URL+"/api/sessions/"+answered.ID+"/skip", "", nil)
+	createSession(t, ts, `{"mode": "addition"}`) // Never answered: not saved
+
+	srv.Close()
+	if n := saves.n.Load(); n != 1 {
+		t.Errorf("saved %d sessions on close, want 1", n)
+	}
+}
+
+func TestConcurrentSessions(t *testing.T) {
+	saves := &countingSave{}
+	_, ts := newTestServer(t, Options{Save: saves.save})
+
+	const players, questions = 16, 5
+	var wg sync.WaitGroup
+	for i := 0; i < players; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			state := createSession(t, ts, fmt.Sprintf(`{"mode": "mixed-basics", "count": %d}`, questions))
+			base := ts.URL + "/api/sessions/" + state.ID
+			for j := 0; j < questions; j++ {
+				var resp answerResponse
+				do(t, "POST", base+"/answer", `{"answer": 0}`, &resp)
+				if resp.State.Asked != j+1 {
+					t.Errorf("asked = %d, want %d", resp.State.Asked, j+1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := saves.n.Load(); n != players {
+		t.Errorf("saved %d sessions, want %d", n, players)
+	}
+}
+
+func TestStatisticsEndpoints(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	save := func(s *game.Session, modeName string, elapsed time.Duration) (string, error) {
+		record, err := history.NewRecord(s, modeName, elapsed)
+		if err != nil {
+			return "", err
+		}
+		return record.ID, history.Save(record)
+	}
+	_, ts := newTestServer(t, Options{Save: save})
+
+	state := createSession(t, ts, `{"mode": "addition", "difficulty": "easy", "count": 1}`)
+	do(t, "POST", ts.URL+"/api/sessions/"+state.ID+"/skip", "", nil)
+
+	var stats statisticsView
+	if code := do(t, "GET", ts.URL+"/api/statistics?period=7d&mode=addition", "", &stats); code != http.StatusOK {
+		t.Fatalf("statistics: status %d", code)
+	}
+	if stats.Sessions != 1 {
+		t.Errorf("Sessions = %d, want 1", stats.Sessions)
+	}
+
+	var sessions []storage.SessionRecord
+	do(t, "GET", ts.URL+"/api/statistics/history?difficulty=easy", "", &sessions)
+	if len(sessions) != 1 || sessions[0].Mode != "Addition" || sessions[0].QuestionsSkipped != 1 {
+		t.Errorf("history = %+v", sessions)
+	}
+
+	do(t, "GET", ts.URL+"/api/statistics/history?difficulty=hard", "", &sessions)
+	if len(sessions) != 0 {
+		t.Errorf("hard history has %d sessions, want 0", len(sessions))
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/modes"
+)
+
+// liveSession is a session held by the server. All fields are guarded by mu.
+type liveSession struct {
+	mu sync.Mutex
+
+	id         string
+	mode       *modes.Mode
+	session    *game.Session
+	count      int // Questions to ask; 0 for timed sessions
+	asked      int // Questions answered or skipped
+	lastActive time.Time
+
+	finished bool
+	elapsed  time.Duration
+	recordID string // ID of the saved statistics record
+	saveErr  error
+}
+
+// timeUp reports whether a timed session has run out of time.
+func (ls *liveSession) timeUp() bool {
+	if ls.finished || ls.session.Duration == 0 {
+		return false
+	}
+	ls.session.Tick()
+	return ls.session.IsFinished()
+}
+
+// QuestionView is the current question.
+type QuestionView struct {
+	N         int    `json:"n"` // 1-based question number
+	Question  string `json:"question"`
+	Operation string `json:"operation"`
+}
+
+// ResultView is the outcome of an answer or skip.
+type ResultView struct {
+	Correct       bool  `json:"correct"`
+	Skipped       bool  `json:"skipped"`
+	Answer        int   `json:"answer"`
+	CorrectAnswer int   `json:"correct_answer"`
+	Points        int   `json:"points"`
+	ResponseMs    int64 `json:"response_ms"`
+}
+
+// StateView is a session's progress. Question is nil once it is finished.
+type StateView struct {
+	ID            string        `json:"id"`
+	Mode          string        `json:"mode"`
+	ModeName      string        `json:"mode_name"`
+	Difficulty    string        `json:"difficulty"`
+	DurationMs    int64         `json:"duration_ms"` // 0 for count-based sessions
+	TimeLeftMs    int64         `json:"time_left_ms"`
+	Count         int           `json:"count"` // 0 for timed sessions
+	Asked         int           `json:"asked"`
+	Score         int           `json:"score"`
+	Correct       int           `json:"correct"`
+	Wrong         int           `json:"wrong"`
+	Skipped       int           `json:"skipped"`
+	Streak        int           `json:"streak"`
+	BestStreak    int           `json:"best_streak"`
+	Accuracy      float64       `json:"accuracy"`
+	AvgResponseMs int64         `json:"avg_response_ms"`
+	Finished      bool          `json:"finished"`
+	Question      *QuestionView `json:"question,omitempty"`
+	RecordID      string        `json:"record_id,omitempty"` // Set once saved to statistics
+	SaveError     string        `json:"save_error,omitempty"`
+}
+
+// answerResponse is the body returned for an answer or skip.
+type answerResponse struct {
+	Result ResultView `json:"result"`
+	State  StateView  `json:"state"`
+}
+
+func (ls *liveSession) question() *QuestionView {
+	q := ls.session.Current
+	if q == nil {
+		return nil
+	}
+	return &QuestionView{N: ls.asked + 1, Question: q.Display, Operation: q.OpLabel}
+}
+
+func (ls *liveSession) lastResult() ResultView {
+	h := ls.session.History[len(ls.session.History)-1]
+	return ResultView{
+		Correct:       h.Correct,
+		Skipped:       h.Skipped,
+		Answer:        h.UserAnswer,
+		CorrectAnswer: h.CorrectAnswer,
+		Points:        h.PointsEarned,
+		ResponseMs:    h.ResponseTime.Milliseconds(),
+	}
+}
+
+func (ls *liveSession) state() StateView {
+	s := ls.session
+	v := StateView{
+		ID:            ls.id,
+		Mode:          ls.mode.ID,
+		ModeName:      ls.mode.Name,
+		Difficulty:    s.Difficulty.String(),
+		DurationMs:    s.Duration.Milliseconds(),
+		TimeLeftMs:    s.TimeLeft.Milliseconds(),
+		Count:         ls.count,
+		Asked:         ls.asked,
+		Score:         s.Score,
+		Correct:       s.Correct,
+		Wrong:         s.Incorrect,
+		Skipped:       s.Skipped,
+		Streak:        s.Streak,
+		BestStreak:    s.BestStreak,
+		Accuracy:      s.Accuracy(),
+		AvgResponseMs: s.AvgResponseTime().Milliseconds(),
+		Finished:      ls.finished,
+		RecordID:      ls.recordID,
+	}
+	if ls.finished && s.Duration > 0 {
+		v.TimeLeftMs = 0
+	}
+	if !ls.finished {
+		v.Question = ls.question()
+	}
+	if ls.saveErr != nil {
+		v.SaveError = ls.saveErr.Error()
+	}
+	return v
+}
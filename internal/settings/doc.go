@@ -0,0 +1,13 @@
+// Package settings describes the user-editable fields of [storage.Config].
+//
+// Each [Field] knows how to read, validate and write one config value as a
+// string. The settings screen and the 'config' command both change values
+// through this package, so they accept and reject the same input:
+//
+//	if err := settings.Set(cfg, "default_difficulty", "hard"); err != nil {
+//	    return err // unknown difficulty "hadr" (valid: ...)
+//	}
+//	err := storage.SaveConfig(cfg)
+//
+// Keys are the JSON names used in config.json.
+package settings
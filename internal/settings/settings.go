@@ -0,0 +1,530 @@
+package settings
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// Config keys, as used in config.json.
+const (
+	KeyOnboarded            = "onboarded"
+	KeyTourCompleted        = "tour_completed"
+	KeyDefaultDifficulty    = "default_difficulty"
+	KeyDefaultDurationMs    = "default_duration_ms"
+	KeyLastPlayedModeID     = "last_played_mode_id"
+	KeyLastPlayedDifficulty = "last_played_difficulty"
+	KeyLastPlayedDurationMs = "last_played_duration_ms"
+	KeyPracticeCategory     = "practice_category"
+	KeyPracticeOperation    = "practice_operation"
+	KeyPracticeDifficulty   = "practice_difficulty"
+	KeyPracticeInputMethod  = "practice_input_method"
+	KeyAutoUpdate           = "auto_update"
+	KeyInputMethod          = "input_method"
+	KeyQuestionTimer        = "question_timer"
+	KeySkipQuitConfirmation = "skip_quit_confirmation"
+	KeyTheme                = "theme"
+	KeyKeymap               = "keymap"
+	KeyKeyBindings          = "key_bindings"
+	KeyAccessible           = "accessible"
+	KeyNotation             = "notation"
+	KeyFlashLength          = "flash_length"
+	KeyFlashIntervalMs      = "flash_interval_ms"
+	KeyStorageBackend       = "storage_backend"
+)
+
+// Input method values stored in the config.
+const (
+	InputTyping         = "typing"
+	InputMultipleChoice = "multiple_choice"
+)
+
+// Field types reported by [Field.Type].
+const (
+	TypeBool     = "bool"
+	TypeString   = "string"
+	TypeDuration = "duration"
+	TypeInt      = "int"
+)
+
+// Field is one editable config value.
+type Field struct {
+	Key         string
+	Type        string // TypeBool, TypeString, TypeDuration or TypeInt
+	Description string
+	Values      []string // Allowed values; empty for free-form fields
+
+	get   func(c *storage.Config) string
+	set   func(c *storage.Config, value string) error
+	check func(c *storage.Config) error // Validates the config as a whole after set; optional
+}
+
+// Get returns the field's value in c as a string.
+// Durations are milliseconds; unset optional values are empty.
+func (f Field) Get(c *storage.Config) string {
+	return f.get(c)
+}
+
+// Set validates value and stores it in c.
+func (f Field) Set(c *storage.Config, value string) error {
+	next := *c
+	if err := f.set(&next, strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("%s: %w", f.Key, err)
+	}
+	if f.check != nil {
+		if err := f.check(&next); err != nil {
+			return fmt.Errorf("%s: %w", f.Key, err)
+		}
+	}
+	*c = next
+	return nil
+}
+
+// Reset sets the field in c back to its default.
+func (f Field) Reset(c *storage.Config) {
+	// Defaults are always valid, so set cannot fail.
+	_ = f.set(c, f.get(storage.NewConfig()))
+}
+
+// fields lists every config field in config.json order.
+var fields = []Field{
+	boolField(KeyOnboarded, "First-run setup has been completed",
+		func(c *storage.Config) *bool { return &c.Onboarded }),
+	boolField(KeyTourCompleted, "The feature tour has been shown",
+		func(c *storage.Config) *bool { return &c.TourCompleted }),
+
+	stringField(KeyDefaultDifficulty, "Difficulty preselected for new modes", difficultyNames(),
+		func(c *storage.Config) *string { return &c.DefaultDifficulty }, parseDifficulty(false)),
+	durationField(KeyDefaultDurationMs, "Duration preselected for new modes (e.g. 90s or 90000)",
+		func(c *storage.Config) *int64 { return &c.DefaultDurationMs }, ValidateDefaultDuration),
+
+	// Modes register at startup, after this list is built, so mode IDs
+	// are validated but not listed.
+	stringField(KeyLastPlayedModeID, "Mode ID of the last game", nil,
+		func(c *storage.Config) *string { return &c.LastPlayedModeID }, parseModeID),
+	stringField(KeyLastPlayedDifficulty, "Difficulty of the last game", difficultyNames(),
+		func(c *storage.Config) *string { return &c.LastPlayedDifficulty }, parseDifficulty(true)),
+	durationField(KeyLastPlayedDurationMs, "Duration of the last game",
+		func(c *storage.Config) *int64 { return &c.LastPlayedDurationMs }, validateLastPlayedDuration),
+
+	stringField(KeyPracticeCategory, "Category last used in practice", categoryNames(),
+		func(c *storage.Config) *string { return &c.PracticeCategory }, parseCategory),
+	// Operations depend on the practice category, so they are not listed.
+	practiceOperationField(),
+	stringField(KeyPracticeDifficulty, "Difficulty last used in practice", difficultyNames(),
+		func(c *storage.Config) *string { return &c.PracticeDifficulty }, parseDifficulty(true)),
+	stringField(KeyPracticeInputMethod, "Input method last used in practice", inputMethods(),
+		func(c *storage.Config) *string { return &c.PracticeInputMethod }, parseInputMethod),
+
+	boolField(KeyAutoUpdate, "Install updates automatically",
+		func(c *storage.Config) *bool { return &c.AutoUpdate }),
+	stringField(KeyInputMethod, "Answer by typing or multiple choice", inputMethods(),
+		func(c *storage.Config) *string { return &c.InputMethod }, parseInputMethod),
+	boolField(KeyQuestionTimer, "Give each question a countdown that skips it when it runs out (e.g. 5s at Hard)",
+		func(c *storage.Config) *bool { return &c.QuestionTimer }),
+	boolField(KeySkipQuitConfirmation, "Quit games without asking",
+		func(c *storage.Config) *bool { return &c.SkipQuitConfirmation }),
+	// User themes are validated but not listed, like mode IDs.
+	stringField(KeyTheme, "Color theme; user themes are loaded from the themes directory", styles.BuiltinNames(),
+		func(c *storage.Config) *string { return &c.Theme }, parseTheme),
+	// The keymap is checked for conflicts with the bindings applied.
+	stringField(KeyKeymap, "Key preset", keys.PresetNames(),
+		func(c *storage.Config) *string { return &c.Keymap }, parseKeymap).withCheck(checkKeys),
+	stringField(KeyKeyBindings, "Keys for single actions on top of the preset (e.g. skip=tab,space; pause=ctrl+p)", nil,
+		func(c *storage.Config) *string { return &c.KeyBindings }, parseKeyBindings).withCheck(checkKeys),
+	boolField(KeyAccessible, "Screen-reader friendly output: spelled-out operators and no animations",
+		func(c *storage.Config) *bool { return &c.Accessible }),
+	stringField(KeyNotation, "How questions are written; stored history keeps the notation it was played in", expr.Notations(),
+		func(c *storage.Config) *string { return &c.Notation }, parseNotation),
+
+	intField(KeyFlashLength, "Numbers per Flash Anzan sequence; 0 uses the difficulty's default",
+		func(c *storage.Config) *int { return &c.FlashLength }, validateFlashLength),
+	durationField(KeyFlashIntervalMs, "How long Flash Anzan shows each number (e.g. 500ms); 0 uses the difficulty's default",
+		func(c *storage.Config) *int64 { return &c.FlashIntervalMs }, validateFlashInterval),
+
+	stringField(KeyStorageBackend, "Where statistics are stored; use 'arithmego migrate' to move existing history", storage.AllBackends(),
+		func(c *storage.Config) *string { return &c.StorageBackend }, parseBackend),
+}
+
+// Fields returns all editable config fields.
+func Fields() []Field {
+	return fields
+}
+
+// Lookup returns the field with the given key.
+func Lookup(key string) (Field, error) {
+	for _, f := range fields {
+		if f.Key == key {
+			return f, nil
+		}
+	}
+	return Field{}, fmt.Errorf("unknown config key %q (run 'arithmego config list' to see all keys)", key)
+}
+
+// Get returns the value of key in c.
+func Get(c *storage.Config, key string) (string, error) {
+	f, err := Lookup(key)
+	if err != nil {
+		return "", err
+	}
+	return f.Get(c), nil
+}
+
+// Set validates value and stores it under key in c.
+func Set(c *storage.Config, key, value string) error {
+	f, err := Lookup(key)
+	if err != nil {
+		return err
+	}
+	return f.Set(c, value)
+}
+
+// Reset sets key in c back to its default.
+func Reset(c *storage.Config, key string) error {
+	f, err := Lookup(key)
+	if err != nil {
+		return err
+	}
+	f.Reset(c)
+	return nil
+}
+
+// ValidateDefaultDuration returns an error unless d is one of
+// modes.AllowedDurations, the only values the settings screen can show.
+func ValidateDefaultDuration(d time.Duration) error {
+	if modes.IsAllowedDuration(d) {
+		return nil
+	}
+	labels := make([]string, len(modes.AllowedDurations))
+	for i, dur := range modes.AllowedDurations {
+		labels[i] = fmt.Sprintf("%ds", int(dur.Value.Seconds()))
+	}
+	return fmt.Errorf("duration %s not allowed (valid: %s)", d, strings.Join(labels, ", "))
+}
+
+func validateLastPlayedDuration(d time.Duration) error {
+	if d == 0 || (d >= modes.MinDuration && d <= modes.MaxDuration) {
+		return nil
+	}
+	return fmt.Errorf("duration %s out of range (%s to %s, or 0)", d, modes.MinDuration, modes.MaxDuration)
+}
+
+func validateFlashLength(n int) error {
+	if n == 0 || (n >= gen.FlashMinLength && n <= gen.FlashMaxLength) {
+		return nil
+	}
+	return fmt.Errorf("length %d out of range (%d to %d, or 0)", n, gen.FlashMinLength, gen.FlashMaxLength)
+}
+
+func validateFlashInterval(d time.Duration) error {
+	if d == 0 || (d >= gen.FlashMinInterval && d <= gen.FlashMaxInterval) {
+		return nil
+	}
+	return fmt.Errorf("interval %s out of range (%s to %s, or 0)", d, gen.FlashMinInterval, gen.FlashMaxInterval)
+}
+
+// Field constructors
+
+// withCheck returns f with a check of the whole config after each set.
+func (f Field) withCheck(check func(c *storage.Config) error) Field {
+	f.check = check
+	return f
+}
+
+func boolField(key, desc string, ptr func(*storage.Config) *bool) Field {
+	return Field{
+		Key:         key,
+		Type:        TypeBool,
+		Description: desc,
+		Values:      []string{"true", "false"},
+		get: func(c *storage.Config) string {
+			return strconv.FormatBool(*ptr(c))
+		},
+		set: func(c *storage.Config, value string) error {
+			b, err := parseBool(value)
+			if err != nil {
+				return err
+			}
+			*ptr(c) = b
+			return nil
+		},
+	}
+}
+
+func stringField(key, desc string, values []string, ptr func(*storage.Config) *string, parse func(string) (string, error)) Field {
+	return Field{
+		Key:         key,
+		Type:        TypeString,
+		Description: desc,
+		Values:      values,
+		get: func(c *storage.Config) string {
+			return *ptr(c)
+		},
+		set: func(c *storage.Config, value string) error {
+			v, err := parse(value)
+			if err != nil {
+				return err
+			}
+			*ptr(c) = v
+			return nil
+		},
+	}
+}
+
+func durationField(key, desc string, ptr func(*storage.Config) *int64, validate func(time.Duration) error) Field {
+	return Field{
+		Key:         key,
+		Type:        TypeDuration,
+		Description: desc,
+		get: func(c *storage.Config) string {
+			return strconv.FormatInt(*ptr(c), 10)
+		},
+		set: func(c *storage.Config, value string) error {
+			d, err := parseDurationMs(value)
+			if err != nil {
+				return err
+			}
+			if err := validate(d); err != nil {
+				return err
+			}
+			*ptr(c) = d.Milliseconds()
+			return nil
+		},
+	}
+}
+
+func intField(key, desc string, ptr func(*storage.Config) *int, validate func(int) error) Field {
+	return Field{
+		Key:         key,
+		Type:        TypeInt,
+		Description: desc,
+		get: func(c *storage.Config) string {
+			return strconv.Itoa(*ptr(c))
+		},
+		set: func(c *storage.Config, value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid number %q", value)
+			}
+			if err := validate(n); err != nil {
+				return err
+			}
+			*ptr(c) = n
+			return nil
+		},
+	}
+}
+
+// Parsers. Optional fields accept "" to clear the value.
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q (use true or false)", s)
+}
+
+// parseDurationMs parses a Go duration ("90s") or plain milliseconds ("90000").
+func parseDurationMs(s string) (time.Duration, error) {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q (use e.g. 90s or 90000)", s)
+	}
+	return d, nil
+}
+
+func parseDifficulty(optional bool) func(string) (string, error) {
+	return func(s string) (string, error) {
+		if s == "" && optional {
+			return "", nil
+		}
+		d, err := game.ParseDifficulty(s)
+		if err != nil {
+			return "", err
+		}
+		return d.String(), nil
+	}
+}
+
+func parseModeID(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	mode, ok := modes.Get(strings.ToLower(s))
+	if !ok {
+		return "", fmt.Errorf("unknown mode %q", s)
+	}
+	return mode.ID, nil
+}
+
+func parseCategory(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, name := range categoryNames() {
+		if strings.EqualFold(s, name) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unknown category %q (valid: %s)", s, strings.Join(categoryNames(), ", "))
+}
+
+func parseInputMethod(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return "", nil
+	case InputTyping:
+		return InputTyping, nil
+	case InputMultipleChoice, "choice":
+		return InputMultipleChoice, nil
+	}
+	return "", fmt.Errorf("unknown input method %q (valid: %s)", s, strings.Join(inputMethods(), ", "))
+}
+
+func parseBackend(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	s = strings.ToLower(s)
+	if err := storage.ValidateBackend(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func parseTheme(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	s = strings.ToLower(s)
+	if _, err := styles.Lookup(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func parseKeymap(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	s = strings.ToLower(s)
+	if !slices.Contains(keys.PresetNames(), s) {
+		return "", fmt.Errorf("unknown keymap %q (valid: %s)", s, strings.Join(keys.PresetNames(), ", "))
+	}
+	return s, nil
+}
+
+func parseKeyBindings(s string) (string, error) {
+	overrides, err := keys.ParseBindings(s)
+	if err != nil {
+		return "", err
+	}
+	return keys.FormatBindings(overrides), nil
+}
+
+func parseNotation(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	s = strings.ToLower(s)
+	if _, err := expr.Lookup(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// checkKeys rejects a keymap and bindings that conflict with each other.
+func checkKeys(c *storage.Config) error {
+	_, err := keys.Load(c.Keymap, c.KeyBindings)
+	return err
+}
+
+// practiceOperationField is the practice operation, checked against the
+// operations of the configured practice category.
+func practiceOperationField() Field {
+	return Field{
+		Key:         KeyPracticeOperation,
+		Type:        TypeString,
+		Description: "Operation last used in practice (name or Mixed)",
+		get: func(c *storage.Config) string {
+			return c.PracticeOperation
+		},
+		set: func(c *storage.Config, value string) error {
+			op, err := parsePracticeOperation(c.PracticeCategory, value)
+			if err != nil {
+				return err
+			}
+			c.PracticeOperation = op
+			return nil
+		},
+	}
+}
+
+func parsePracticeOperation(category, s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	// Practice starts in the basic category when none is set
+	if category == "" {
+		category = string(game.CategoryBasic)
+	}
+	ops := practiceOperations(category)
+	for _, op := range ops {
+		if strings.EqualFold(s, op) {
+			return op, nil
+		}
+	}
+	return "", fmt.Errorf("unknown operation %q for practice category %s (valid: %s)", s, category, strings.Join(ops, ", "))
+}
+
+// Allowed values
+
+func difficultyNames() []string {
+	var names []string
+	for _, d := range game.AllDifficulties() {
+		names = append(names, d.String())
+	}
+	return names
+}
+
+func categoryNames() []string {
+	return []string{string(game.CategoryBasic), string(game.CategoryPower), string(game.CategoryAdvanced)}
+}
+
+// practiceGroups maps practice categories to the mode group holding their
+// single-operation modes.
+var practiceGroups = map[game.Category]string{
+	game.CategoryBasic:    modes.GroupBasics,
+	game.CategoryPower:    modes.GroupPowers,
+	game.CategoryAdvanced: modes.GroupAdvanced,
+}
+
+// practiceOperations returns the operations practice offers in category:
+// the generator labels of its modes, then Mixed.
+func practiceOperations(category string) []string {
+	var ops []string
+	for _, m := range modes.ByGroup(practiceGroups[game.Category(category)]) {
+		ops = append(ops, m.GeneratorLabel)
+	}
+	return append(ops, "Mixed")
+}
+
+func inputMethods() []string {
+	return []string{InputTyping, InputMultipleChoice}
+}
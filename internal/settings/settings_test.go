@@ -0,0 +1,159 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+func TestFieldsHaveUniqueKeys(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, f := range Fields() {
+		if seen[f.Key] {
+			t.Errorf("duplicate key %q", f.Key)
+		}
+		seen[f.Key] = true
+	}
+	if len(seen) != 23 {
+		t.Errorf("got %d fields, want one per storage.Config field (23)", len(seen))
+	}
+}
+
+func TestSetAndGet(t *testing.T) {
+	modes.RegisterPresets()
+
+	tests := []struct {
+		key, value, want string
+	}{
+		{KeyOnboarded, "yes", "true"},
+		{KeyQuestionTimer, "on", "true"},
+		{KeyDefaultDifficulty, "hard", "Hard"},
+		{KeyDefaultDurationMs, "90s", "90000"},
+		{KeyDefaultDurationMs, "30000", "30000"},
+		{KeyLastPlayedModeID, "Addition", "addition"},
+		{KeyLastPlayedDurationMs, "45s", "45000"},
+		{KeyPracticeCategory, "POWER", "power"},
+		{KeyPracticeOperation, "division", "Division"},
+		{KeyPracticeOperation, "MIXED", "Mixed"},
+		{KeyInputMethod, "choice", InputMultipleChoice},
+		{KeyStorageBackend, "SQLite", storage.BackendSQLite},
+		{KeyPracticeDifficulty, "", ""},
+		{KeyTheme, "High-Contrast", "high-contrast"},
+		{KeyKeymap, "Vim", "vim"},
+		{KeyKeyBindings, " Skip = Tab,SPACE ;pause=ctrl+p", "pause=ctrl+p; skip=tab,space"},
+		{KeyNotation, "LaTeX", "latex"},
+		{KeyFlashLength, "12", "12"},
+		{KeyFlashLength, "0", "0"},
+		{KeyFlashIntervalMs, "500ms", "500"},
+	}
+	for _, tt := range tests {
+		c := storage.NewConfig()
+		if err := Set(c, tt.key, tt.value); err != nil {
+			t.Errorf("Set(%s, %q) error = %v", tt.key, tt.value, err)
+			continue
+		}
+		if got, _ := Get(c, tt.key); got != tt.want {
+			t.Errorf("Set(%s, %q) stored %q, want %q", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSetRejectsInvalidValues(t *testing.T) {
+	modes.RegisterPresets()
+
+	tests := []struct{ key, value string }{
+		{KeyAutoUpdate, "maybe"},
+		{KeyDefaultDifficulty, "medum"},
+		{KeyDefaultDifficulty, ""},
+		{KeyDefaultDurationMs, "45s"}, // not on the settings screen
+		{KeyDefaultDurationMs, "soon"},
+		{KeyLastPlayedModeID, "add"},
+		{KeyLastPlayedDurationMs, "2s"},
+		{KeyPracticeCategory, "geometry"},
+		{KeyPracticeOperation, "bogus"},
+		{KeyPracticeOperation, "Square Root"}, // a power, and practice starts on basic
+		{KeyInputMethod, "voice"},
+		{KeyStorageBackend, "postgres"},
+		{KeyTheme, "no-such-theme"},
+		{KeyKeymap, "emacs"},
+		{KeyKeyBindings, "jump=x"},
+		{KeyKeyBindings, "skip="},
+		{KeyKeyBindings, "pause=s"},  // skip uses s in the game
+		{KeyKeyBindings, "submit=5"}, // digits type answers
+		{KeyNotation, "roman"},
+		{KeyFlashLength, "1"},
+		{KeyFlashLength, "many"},
+		{KeyFlashIntervalMs, "10ms"},
+		{"no_such_key", "1"},
+	}
+	for _, tt := range tests {
+		c := storage.NewConfig()
+		before := *c
+		if err := Set(c, tt.key, tt.value); err == nil {
+			t.Errorf("Set(%s, %q) should fail", tt.key, tt.value)
+		}
+		if *c != before {
+			t.Errorf("Set(%s, %q) changed the config on error", tt.key, tt.value)
+		}
+	}
+}
+
+func TestPracticeOperationFollowsCategory(t *testing.T) {
+	modes.RegisterPresets()
+
+	c := storage.NewConfig()
+	if err := Set(c, KeyPracticeCategory, "power"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Set(c, KeyPracticeOperation, "square root"); err != nil {
+		t.Errorf("Set(%s, %q) error = %v", KeyPracticeOperation, "square root", err)
+	}
+	if c.PracticeOperation != "Square Root" {
+		t.Errorf("PracticeOperation = %q, want %q", c.PracticeOperation, "Square Root")
+	}
+	if err := Set(c, KeyPracticeOperation, "Addition"); err == nil {
+		t.Error("Addition should be rejected in the power category")
+	}
+}
+
+func TestKeymapCheckedWithBindings(t *testing.T) {
+	c := storage.NewConfig()
+	if err := Set(c, KeyKeyBindings, "menu=8"); err != nil {
+		t.Fatalf("Set(key_bindings) error = %v", err)
+	}
+	// The numpad preset moves up to 8, which the results screen also uses.
+	if err := Set(c, KeyKeymap, "numpad"); err == nil {
+		t.Error("Set(keymap, numpad) should fail with conflicting bindings")
+	}
+	if c.Keymap != "" {
+		t.Errorf("keymap = %q after a failed set, want unchanged", c.Keymap)
+	}
+}
+
+func TestDefaultDurationMatchesSettingsScreen(t *testing.T) {
+	for _, d := range modes.AllowedDurations {
+		if err := ValidateDefaultDuration(d.Value); err != nil {
+			t.Errorf("ValidateDefaultDuration(%v) error = %v", d.Value, err)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	c := storage.NewConfig()
+	c.Onboarded = true
+	c.DefaultDifficulty = "Expert"
+	c.DefaultDurationMs = 120000
+	c.InputMethod = InputMultipleChoice
+
+	for _, f := range Fields() {
+		f.Reset(c)
+	}
+	if *c != *storage.NewConfig() {
+		t.Errorf("after resetting every field, config = %+v, want defaults", *c)
+	}
+
+	if err := Reset(c, "no_such_key"); err == nil {
+		t.Error("Reset of unknown key should fail")
+	}
+}
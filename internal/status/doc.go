@@ -0,0 +1,24 @@
+// Package status keeps a small summary of the statistics for status lines.
+//
+// Shell prompts and tmux call the status command every few seconds, so it
+// must not parse the whole history each time. The summary is cached in
+// status.json next to the statistics and stamped with the size and
+// modification time of the statistics files. [Load] returns the cached
+// summary while the stamp matches and rebuilds it from the storage backend
+// when it does not:
+//
+//	summary, err := status.Load()
+//
+// Saving a session through the history package calls [Update], which adds
+// the new record to a current cache instead of invalidating it:
+//
+//	before := status.CurrentStamp()
+//	err := backend.AddSession(record)
+//	status.Update(before, record)
+//
+// The daily streak counts consecutive days with at least one session. It
+// ends today, or yesterday while today has no sessions yet, so the streak
+// shown in the morning is still the one to keep alive. Questions are due for
+// review when their latest answer was wrong; they are matched by their
+// canonical key, so a notation change does not split or duplicate them.
+package status
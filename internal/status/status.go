@@ -0,0 +1,272 @@
+package status
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// cacheVersion is increased when the cache layout changes.
+const cacheVersion = 2
+
+// keepDays is how many days of per-day session counts the cache keeps.
+const keepDays = 400
+
+// dayLayout is the key format for per-day session counts (local time).
+const dayLayout = "2006-01-02"
+
+// Summary is the information shown in a status line.
+type Summary struct {
+	Today      int       // Sessions played today
+	Streak     int       // Consecutive days played, ending today or yesterday
+	Sessions   int       // Sessions played in total
+	LastScore  int       // Score of the most recent session
+	LastMode   string    // Mode of the most recent session
+	LastPlayed time.Time // Zero if no session was played
+	Due        int       // Questions whose latest answer was wrong
+}
+
+// fileStamp identifies one version of a file. A missing file has a zero stamp.
+type fileStamp struct {
+	Size    int64 `json:"size"`
+	ModTime int64 `json:"mod_time"` // Unix nanoseconds, so stamps compare with ==
+}
+
+// Stamp identifies the current contents of the statistics files of both
+// storage backends.
+type Stamp struct {
+	JSON      fileStamp `json:"json"`
+	SQLite    fileStamp `json:"sqlite"`
+	SQLiteWAL fileStamp `json:"sqlite_wal"`
+}
+
+// cache is the summary stored in status.json.
+type cache struct {
+	Version    int             `json:"version"`
+	Source     Stamp           `json:"source"`
+	Sessions   int             `json:"sessions"`
+	Days       map[string]int  `json:"days"` // Sessions per day
+	LastScore  int             `json:"last_score"`
+	LastMode   string          `json:"last_mode"`
+	LastPlayed time.Time       `json:"last_played"`
+	Due        map[string]bool `json:"due"` // Keys of questions whose latest answer was wrong
+}
+
+// CurrentStamp returns the stamp of the active profile's statistics files.
+func CurrentStamp() Stamp {
+	var s Stamp
+	if path, err := storage.StatisticsPath(); err == nil {
+		s.JSON = statFile(path)
+	}
+	if path, err := storage.SQLitePath(); err == nil {
+		// In WAL mode new sessions land in the -wal file first.
+		s.SQLite = statFile(path)
+		s.SQLiteWAL = statFile(path + "-wal")
+	}
+	return s
+}
+
+func statFile(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+}
+
+// Load returns the summary for the active profile. The cached summary is
+// used while the statistics are unchanged; otherwise it is rebuilt from the
+// storage backend and cached again.
+func Load() (Summary, error) {
+	stamp := CurrentStamp()
+	if c, ok := readCache(); ok && c.Source == stamp {
+		return c.summary(time.Now()), nil
+	}
+
+	backend, err := storage.Open()
+	if err != nil {
+		return Summary{}, err
+	}
+	defer backend.Close()
+
+	stats, err := backend.Load()
+	if err != nil {
+		return Summary{}, err
+	}
+
+	c := build(stats)
+	c.Source = stamp
+	// The cache is non-critical; a failed write only means rebuilding next time.
+	_ = writeCache(c)
+	return c.summary(time.Now()), nil
+}
+
+// Update adds a record that was just saved to the cache. before is the stamp
+// taken before saving. If the cache did not match it, the cache is left
+// alone and the next [Load] rebuilds it.
+func Update(before Stamp, record storage.SessionRecord) {
+	c, ok := readCache()
+	if !ok || c.Source != before {
+		return
+	}
+	c.add(record)
+	c.Source = CurrentStamp()
+	_ = writeCache(c)
+}
+
+// build computes the cache from all sessions.
+func build(stats *storage.Statistics) *cache {
+	sessions := make([]storage.SessionRecord, len(stats.Sessions))
+	copy(sessions, stats.Sessions)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Timestamp.Before(sessions[j].Timestamp)
+	})
+
+	c := newCache()
+	for _, s := range sessions {
+		c.add(s)
+	}
+	return c
+}
+
+func newCache() *cache {
+	return &cache{
+		Version: cacheVersion,
+		Days:    make(map[string]int),
+		Due:     make(map[string]bool),
+	}
+}
+
+// add counts a session. Sessions must be added in chronological order for
+// due questions to reflect the latest answers.
+func (c *cache) add(s storage.SessionRecord) {
+	c.Sessions++
+	c.Days[s.Timestamp.Local().Format(dayLayout)]++
+	if !s.Timestamp.Before(c.LastPlayed) {
+		c.LastPlayed = s.Timestamp
+		c.LastScore = s.Score
+		c.LastMode = s.Mode
+	}
+
+	for _, q := range s.Questions {
+		switch {
+		case q.Skipped:
+			// Skipping neither adds nor clears a review.
+		case q.Correct:
+			delete(c.Due, reviewKey(q))
+		default:
+			c.Due[reviewKey(q)] = true
+		}
+	}
+}
+
+// reviewKey identifies a question independently of the notation it was
+// shown in. Older records without a key fall back to the question text.
+func reviewKey(q storage.QuestionRecord) string {
+	if q.Key != "" {
+		return q.Key
+	}
+	return q.Question
+}
+
+// summary returns the summary as of now.
+func (c *cache) summary(now time.Time) Summary {
+	today := startOfDay(now)
+	return Summary{
+		Today:      c.Days[today.Format(dayLayout)],
+		Streak:     c.streak(today),
+		Sessions:   c.Sessions,
+		LastScore:  c.LastScore,
+		LastMode:   c.LastMode,
+		LastPlayed: c.LastPlayed,
+		Due:        len(c.Due),
+	}
+}
+
+// streak counts consecutive days with sessions, ending today or yesterday.
+func (c *cache) streak(today time.Time) int {
+	day := today
+	if c.Days[day.Format(dayLayout)] == 0 {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	streak := 0
+	for streak < keepDays && c.Days[day.Format(dayLayout)] > 0 {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+	return streak
+}
+
+// trim drops per-day counts older than keepDays.
+func (c *cache) trim(now time.Time) {
+	cutoff := startOfDay(now).AddDate(0, 0, -keepDays).Format(dayLayout)
+	for day := range c.Days {
+		if day < cutoff {
+			delete(c.Days, day)
+		}
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// readCache reads status.json. It reports false if the file is missing,
+// damaged or from another cache version.
+func readCache() (*cache, bool) {
+	path, err := storage.StatusPath()
+	if err != nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	c := newCache()
+	if err := json.Unmarshal(data, c); err != nil || c.Version != cacheVersion {
+		return nil, false
+	}
+	if c.Days == nil || c.Due == nil {
+		return nil, false
+	}
+	return c, true
+}
+
+// writeCache writes status.json atomically.
+func writeCache(c *cache) error {
+	path, err := storage.StatusPath()
+	if err != nil {
+		return err
+	}
+
+	c.trim(time.Now())
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "status-*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package status
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+func session(ts time.Time, score int, questions ...storage.QuestionRecord) storage.SessionRecord {
+	return storage.SessionRecord{Timestamp: ts, Mode: "Addition", Difficulty: "Easy", Score: score, Questions: questions}
+}
+
+func question(text string, correct, skipped bool) storage.QuestionRecord {
+	return storage.QuestionRecord{Question: text, Correct: correct, Skipped: skipped}
+}
+
+func TestSummary(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.Local)
+	day := func(offset int) time.Time { return now.AddDate(0, 0, offset) }
+
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{
+		// Out of order on purpose: the latest answer decides what is due.
+		session(day(-1), 40, question("2 + 2", true, false)),
+		session(day(-2), 30, question("2 + 2", false, false), question("3 × 3", false, false)),
+		session(day(-3), 20),
+		session(day(-5), 10, question("9 - 4", false, false)),
+		session(day(-1).Add(time.Hour), 50, question("9 - 4", false, true)),
+	}}
+
+	got := build(stats).summary(now)
+	want := Summary{
+		Today:      0,
+		Streak:     3, // Yesterday back to three days ago
+		Sessions:   5,
+		LastScore:  50,
+		LastMode:   "Addition",
+		LastPlayed: day(-1).Add(time.Hour),
+		Due:        2, // 3 × 3 and 9 - 4; 2 + 2 was answered correctly later
+	}
+	if got != want {
+		t.Errorf("summary =\n%+v\nwant\n%+v", got, want)
+	}
+
+	c := build(stats)
+	c.add(session(now, 60))
+	if got := c.summary(now); got.Today != 1 || got.Streak != 4 || got.LastScore != 60 {
+		t.Errorf("after playing today: %+v", got)
+	}
+	if got := c.summary(day(2)); got.Today != 0 || got.Streak != 0 {
+		t.Errorf("two days later: %+v", got)
+	}
+}
+
+func TestDueIgnoresNotation(t *testing.T) {
+	now := time.Now()
+	wrong := storage.QuestionRecord{Question: "6 × 7", Key: "(* 6 7)"}
+	right := storage.QuestionRecord{Question: "6 * 7", Key: "(* 6 7)", Correct: true}
+	old := question("8 ÷ 2", false, false) // Recorded before questions had keys
+
+	c := build(&storage.Statistics{Sessions: []storage.SessionRecord{
+		session(now.Add(-time.Hour), 10, wrong, old),
+		session(now, 20, right),
+	}})
+	if got := c.summary(now).Due; got != 1 {
+		t.Errorf("Due = %d, want 1 (the same question answered in another notation clears it)", got)
+	}
+}
+
+func TestLoadUsesAndRebuildsCache(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	if got, err := Load(); err != nil || got.Sessions != 0 {
+		t.Fatalf("Load() with no statistics = %+v, %v", got, err)
+	}
+
+	if err := storage.AddSession(session(time.Now(), 42)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Sessions != 1 || got.Today != 1 || got.LastScore != 42 {
+		t.Errorf("Load() after adding a session = %+v", got)
+	}
+
+	// An unchanged stamp serves the cache without reading the statistics.
+	c, ok := readCache()
+	if !ok || c.Source != CurrentStamp() {
+		t.Fatal("cache was not written with the current stamp")
+	}
+	c.LastScore = 99
+	if err := writeCache(c); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := Load(); got.LastScore != 99 {
+		t.Errorf("LastScore = %d, want cached 99", got.LastScore)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	if _, err := Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	before := CurrentStamp()
+	record := session(time.Now(), 7, question("1 + 1", false, false))
+	if err := storage.AddSession(record); err != nil {
+		t.Fatal(err)
+	}
+	Update(before, record)
+
+	c, ok := readCache()
+	if !ok || c.Source != CurrentStamp() || c.Sessions != 1 || !c.Due["1 + 1"] {
+		t.Fatalf("cache after Update = %+v", c)
+	}
+
+	// A stale cache is left for Load to rebuild.
+	stale := CurrentStamp()
+	stale.JSON.Size++
+	Update(stale, session(time.Now(), 8))
+	if c, _ := readCache(); c.Sessions != 1 {
+		t.Errorf("stale Update changed the cache: %+v", c)
+	}
+}
+
+func TestDamagedCacheIsRebuilt(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	if err := storage.AddSession(session(time.Now(), 5)); err != nil {
+		t.Fatal(err)
+	}
+	path, err := storage.StatusPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Load()
+	if err != nil || got.LastScore != 5 {
+		t.Errorf("Load() with damaged cache = %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Storage backend names, as stored in [Config.StorageBackend].
+const (
+	BackendJSON   = "json"
+	BackendSQLite = "sqlite"
+)
+
+// DefaultBackend is used when the config does not name a backend.
+const DefaultBackend = BackendJSON
+
+// AllBackends returns the names of all storage backends.
+func AllBackends() []string {
+	return []string{BackendJSON, BackendSQLite}
+}
+
+// ValidateBackend returns an error if name is not a known backend.
+func ValidateBackend(name string) error {
+	for _, b := range AllBackends() {
+		if name == b {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown storage backend %q (valid: %s)", name, strings.Join(AllBackends(), ", "))
+}
+
+// Backend stores session history for the active profile.
+type Backend interface {
+	// Name returns the backend name (BackendJSON or BackendSQLite).
+	Name() string
+
+	// Load returns all sessions in chronological order.
+	Load() (*Statistics, error)
+
+	// Query returns the sessions matching q in chronological order.
+	Query(q SessionQuery) (*Statistics, error)
+
+	// AddSession stores a new session.
+	AddSession(record SessionRecord) error
+
+	// Save replaces all stored sessions with stats.
+	Save(stats *Statistics) error
+
+	// Close releases any resources held by the backend.
+	Close() error
+}
+
+// Recoverer is implemented by backends that can repair a damaged store.
+type Recoverer interface {
+	LoadWithRecovery() (*Statistics, *RecoveryReport, error)
+}
+
+// Checker is implemented by backends that can verify their store is intact
+// but cannot repair it.
+type Checker interface {
+	Check() error
+}
+
+// SessionQuery selects sessions by their session-level fields.
+// Zero values match everything.
+type SessionQuery struct {
+	Since      time.Time // Exclude sessions before this time
+	Difficulty string    // Difficulty name, e.g. "Hard"
+	Mode       string    // Mode name, e.g. "Addition"
+}
+
+// Matches reports whether a session satisfies the query.
+func (q SessionQuery) Matches(s SessionRecord) bool {
+	if !q.Since.IsZero() && s.Timestamp.Before(q.Since) {
+		return false
+	}
+	if q.Difficulty != "" && s.Difficulty != q.Difficulty {
+		return false
+	}
+	if q.Mode != "" && s.Mode != q.Mode {
+		return false
+	}
+	return true
+}
+
+// OpenBackend opens the named backend for the active profile.
+func OpenBackend(name string) (Backend, error) {
+	switch name {
+	case BackendJSON, "":
+		return jsonBackend{}, nil
+	case BackendSQLite:
+		return openSQLite()
+	default:
+		return nil, ValidateBackend(name)
+	}
+}
+
+// Open opens the backend selected in the active profile's config.
+func Open() (Backend, error) {
+	config, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return OpenBackend(config.StorageBackend)
+}
+
+// jsonBackend stores sessions in statistics.json using [Load], [Save] and [AddSession].
+type jsonBackend struct{}
+
+func (jsonBackend) Name() string { return BackendJSON }
+
+func (jsonBackend) Load() (*Statistics, error) {
+	stats, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	sortChronologically(stats.Sessions)
+	return stats, nil
+}
+
+func (b jsonBackend) Query(q SessionQuery) (*Statistics, error) {
+	stats, err := b.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]SessionRecord, 0, len(stats.Sessions))
+	for _, s := range stats.Sessions {
+		if q.Matches(s) {
+			matched = append(matched, s)
+		}
+	}
+	return &Statistics{Sessions: matched}, nil
+}
+
+func (jsonBackend) AddSession(record SessionRecord) error { return AddSession(record) }
+
+func (jsonBackend) Save(stats *Statistics) error { return Save(stats) }
+
+func (jsonBackend) LoadWithRecovery() (*Statistics, *RecoveryReport, error) {
+	return LoadWithRecovery()
+}
+
+func (jsonBackend) Close() error { return nil }
+
+// sortChronologically orders sessions oldest first.
+func sortChronologically(sessions []SessionRecord) {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Timestamp.Before(sessions[j].Timestamp)
+	})
+}
+
+// Migrate copies all sessions from one backend to another, replacing the
+// destination's contents, and returns the number of sessions copied.
+func Migrate(from, to Backend) (int, error) {
+	stats, err := from.Load()
+	if err != nil {
+		return 0, fmt.Errorf("read %s: %w", from.Name(), err)
+	}
+	if err := to.Save(stats); err != nil {
+		return 0, fmt.Errorf("write %s: %w", to.Name(), err)
+	}
+	return len(stats.Sessions), nil
+}
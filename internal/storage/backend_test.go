@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func testSessions() []SessionRecord {
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	return []SessionRecord{
+		{ID: "a", Timestamp: base, Mode: "Addition", Difficulty: "Easy", Score: 10,
+			Questions: []QuestionRecord{{Question: "1 + 1", Operation: "Addition", CorrectAnswer: 2, UserAnswer: 2, Correct: true}}},
+		{ID: "b", Timestamp: base.Add(24 * time.Hour), Mode: "Addition", Difficulty: "Hard", Score: 20},
+		{ID: "c", Timestamp: base.Add(48 * time.Hour), Mode: "Subtraction", Difficulty: "Hard", Score: 30},
+	}
+}
+
+func TestValidateBackend(t *testing.T) {
+	for _, name := range AllBackends() {
+		if err := ValidateBackend(name); err != nil {
+			t.Errorf("ValidateBackend(%q) error = %v", name, err)
+		}
+	}
+	if err := ValidateBackend("postgres"); err == nil {
+		t.Error("ValidateBackend(\"postgres\") should fail")
+	}
+}
+
+func TestBackends_SaveLoadQuery(t *testing.T) {
+	for _, name := range AllBackends() {
+		t.Run(name, func(t *testing.T) {
+			SetConfigDirForTesting(t.TempDir())
+			defer SetConfigDirForTesting("")
+
+			b, err := OpenBackend(name)
+			if err != nil {
+				t.Fatalf("OpenBackend() error = %v", err)
+			}
+			defer b.Close()
+
+			if b.Name() != name {
+				t.Errorf("Name() = %q, want %q", b.Name(), name)
+			}
+
+			sessions := testSessions()
+			if err := b.Save(&Statistics{Sessions: sessions[:2]}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			if err := b.AddSession(sessions[2]); err != nil {
+				t.Fatalf("AddSession() error = %v", err)
+			}
+
+			stats, err := b.Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if len(stats.Sessions) != 3 {
+				t.Fatalf("Load() returned %d sessions, want 3", len(stats.Sessions))
+			}
+			if stats.Sessions[0].ID != "a" || stats.Sessions[2].ID != "c" {
+				t.Errorf("Load() order = %s..%s, want a..c", stats.Sessions[0].ID, stats.Sessions[2].ID)
+			}
+			if len(stats.Sessions[0].Questions) != 1 || stats.Sessions[0].Questions[0].Question != "1 + 1" {
+				t.Errorf("questions not preserved: %+v", stats.Sessions[0].Questions)
+			}
+
+			tests := []struct {
+				name  string
+				query SessionQuery
+				want  []string
+			}{
+				{"empty", SessionQuery{}, []string{"a", "b", "c"}},
+				{"difficulty", SessionQuery{Difficulty: "Hard"}, []string{"b", "c"}},
+				{"mode", SessionQuery{Mode: "Addition"}, []string{"a", "b"}},
+				{"since", SessionQuery{Since: sessions[1].Timestamp}, []string{"b", "c"}},
+				{"combined", SessionQuery{Mode: "Addition", Difficulty: "Hard"}, []string{"b"}},
+			}
+			for _, tt := range tests {
+				got, err := b.Query(tt.query)
+				if err != nil {
+					t.Fatalf("Query(%s) error = %v", tt.name, err)
+				}
+				var ids []string
+				for _, s := range got.Sessions {
+					ids = append(ids, s.ID)
+				}
+				if len(ids) != len(tt.want) {
+					t.Errorf("Query(%s) = %v, want %v", tt.name, ids, tt.want)
+					continue
+				}
+				for i := range ids {
+					if ids[i] != tt.want[i] {
+						t.Errorf("Query(%s) = %v, want %v", tt.name, ids, tt.want)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	SetConfigDirForTesting(t.TempDir())
+	defer SetConfigDirForTesting("")
+
+	if err := Save(&Statistics{Sessions: testSessions()}); err != nil {
+		t.Fatal(err)
+	}
+
+	from, _ := OpenBackend(BackendJSON)
+	to, err := OpenBackend(BackendSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer to.Close()
+
+	n, err := Migrate(from, to)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if n != 3 {
+		t.Errorf("Migrate() = %d, want 3", n)
+	}
+
+	stats, err := to.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Sessions) != 3 {
+		t.Errorf("sqlite has %d sessions after migration, want 3", len(stats.Sessions))
+	}
+
+	// Migrating again replaces rather than duplicates.
+	if _, err := Migrate(from, to); err != nil {
+		t.Fatal(err)
+	}
+	stats, _ = to.Load()
+	if len(stats.Sessions) != 3 {
+		t.Errorf("sqlite has %d sessions after second migration, want 3", len(stats.Sessions))
+	}
+}
+
+func TestOpen_UsesConfiguredBackend(t *testing.T) {
+	SetConfigDirForTesting(t.TempDir())
+	defer SetConfigDirForTesting("")
+
+	b, err := Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Name() != BackendJSON {
+		t.Errorf("default backend = %q, want json", b.Name())
+	}
+	_ = b.Close()
+
+	config := NewConfig()
+	config.StorageBackend = BackendSQLite
+	if err := SaveConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err = Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	if b.Name() != BackendSQLite {
+		t.Errorf("configured backend = %q, want sqlite", b.Name())
+	}
+	if c, ok := b.(Checker); !ok {
+		t.Error("sqlite backend cannot check its database")
+	} else if err := c.Check(); err != nil {
+		t.Errorf("Check() on a new database = %v", err)
+	}
+}
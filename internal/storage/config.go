@@ -37,7 +37,20 @@ type Config struct {
 	// Preferences
 	AutoUpdate           bool   `json:"auto_update"`
 	InputMethod          string `json:"input_method,omitempty"` // "typing" or "multiple_choice"
+	QuestionTimer        bool   `json:"question_timer,omitempty"` // Per-question countdown that skips on expiry
 	SkipQuitConfirmation bool   `json:"skip_quit_confirmation"`
+	Theme                string `json:"theme,omitempty"` // Built-in or user theme name; empty means "dark"
+	Keymap               string `json:"keymap,omitempty"`       // "default", "vim" or "numpad"; empty means "default"
+	KeyBindings          string `json:"key_bindings,omitempty"` // Overrides, e.g. "skip=tab; pause=ctrl+p"
+	Accessible           bool   `json:"accessible,omitempty"`   // Screen-reader friendly text, no animations
+	Notation             string `json:"notation,omitempty"`     // "unicode", "ascii", "latex" or "spoken"; empty means "unicode"
+
+	// Flash Anzan (0 uses the difficulty's default)
+	FlashLength     int   `json:"flash_length,omitempty"`      // Numbers per sequence
+	FlashIntervalMs int64 `json:"flash_interval_ms,omitempty"` // How long each number is shown
+
+	// Storage
+	StorageBackend string `json:"storage_backend,omitempty"` // "json" (default) or "sqlite"
 }
 
 // NewConfig creates a new Config with default values.
@@ -73,7 +86,9 @@ func LoadConfig() (*Config, error) {
 	var config Config
 	if err := json.Unmarshal(data, &config); err != nil {
 		// Return default config on parse error.
-		// Config is non-critical and can be regenerated.
+		// Config is non-critical and can be regenerated, but the damaged
+		// file is quarantined so the user can still inspect it.
+		_, _ = quarantine(path)
 		return NewConfig(), nil
 	}
 
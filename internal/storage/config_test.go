@@ -2,6 +2,7 @@ package storage
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -231,3 +232,29 @@ func TestConfigPath(t *testing.T) {
 		t.Error("ConfigPath() should not be empty")
 	}
 }
+
+func TestLoadConfig_CorruptedJSONIsQuarantined(t *testing.T) {
+	tempDir := t.TempDir()
+	SetConfigDirForTesting(tempDir)
+	defer SetConfigDirForTesting("")
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"default_difficulty": `), 0600); err != nil {
+		t.Fatalf("Failed to write corrupted data: %v", err)
+	}
+
+	if _, err := LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("corrupted config should be moved aside")
+	}
+	matches, _ := filepath.Glob(filepath.Join(tempDir, "config.corrupt-*.json"))
+	if len(matches) != 1 {
+		t.Errorf("expected 1 quarantined config, got %d", len(matches))
+	}
+}
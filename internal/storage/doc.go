@@ -6,7 +6,8 @@
 //     Stored in config.json. Non-critical data that falls back to defaults on error.
 //
 //   - Statistics ([Statistics]): Game session history with detailed question records.
-//     Stored in statistics.json. Critical data that returns errors on corruption.
+//     Stored in statistics.json. Critical data that returns errors on corruption;
+//     use [LoadWithRecovery] to repair a damaged file instead.
 //
 // All files are stored in the user's config directory under "arithmego".
 // Use [ConfigDir] to get the directory path, or [ConfigPath] and [StatisticsPath]
@@ -14,4 +15,28 @@
 //
 // Both [SaveConfig] and [Save] use atomic writes (write to temp file, then rename)
 // to prevent data corruption on crashes or power loss.
+//
+// # Backends
+//
+// Session history is accessed through a [Backend]. The JSON backend uses
+// statistics.json via [Load], [Save] and [AddSession]; the SQLite backend uses
+// statistics.db with a pure-Go driver. [Open] returns the backend named by
+// [Config.StorageBackend], and [Backend.Query] applies a [SessionQuery] inside
+// the backend (as a WHERE clause for SQLite). [Migrate] copies all sessions
+// from one backend to another.
+//
+// # Recovery
+//
+// [Save] keeps three rotating backups (statistics.json.1 to .3). When the
+// statistics file fails to parse, [LoadWithRecovery] moves it aside as
+// statistics.corrupt-<time>.json, salvages intact sessions with [SalvageSessions],
+// merges them with the newest readable backup, and returns a [RecoveryReport].
+// A damaged config.json is quarantined the same way before defaults are used.
+//
+// # Profiles
+//
+// Each profile has its own config.json and statistics.json. The [DefaultProfile]
+// uses the top-level files; other profiles live under profiles/<name>/.
+// [SetActiveProfile] switches the paths returned by [ConfigPath] and
+// [StatisticsPath], and [SaveSelectedProfile] remembers the choice in profiles.json.
 package storage
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MergeConflict describes an incoming session whose ID already exists locally
+// with different data. The local copy is always kept.
+type MergeConflict struct {
+	Local    SessionRecord
+	Incoming SessionRecord
+}
+
+// InvalidRecord describes an incoming session that failed validation.
+type InvalidRecord struct {
+	Index int // 1-based position in the incoming list
+	ID    string
+	Err   error
+}
+
+// MergeResult summarizes the outcome of merging sessions into statistics.
+type MergeResult struct {
+	Added      []SessionRecord
+	Duplicates int // identical sessions already present
+	Conflicts  []MergeConflict
+	Invalid    []InvalidRecord
+}
+
+// Merge adds incoming sessions to stats, deduplicating by session ID.
+// Invalid records are skipped, identical sessions are counted as duplicates,
+// and sessions whose ID exists with different data are reported as conflicts
+// without replacing the local copy. Sessions are kept in chronological order.
+func Merge(stats *Statistics, incoming []SessionRecord) MergeResult {
+	var result MergeResult
+
+	byID := make(map[string]SessionRecord, len(stats.Sessions))
+	for _, s := range stats.Sessions {
+		byID[s.ID] = s
+	}
+
+	for i, s := range incoming {
+		if err := s.Validate(); err != nil {
+			result.Invalid = append(result.Invalid, InvalidRecord{Index: i + 1, ID: s.ID, Err: err})
+			continue
+		}
+
+		if existing, ok := byID[s.ID]; ok {
+			if sameSession(existing, s) {
+				result.Duplicates++
+			} else {
+				result.Conflicts = append(result.Conflicts, MergeConflict{Local: existing, Incoming: s})
+			}
+			continue
+		}
+
+		if s.Questions == nil {
+			s.Questions = []QuestionRecord{}
+		}
+		byID[s.ID] = s
+		stats.Sessions = append(stats.Sessions, s)
+		result.Added = append(result.Added, s)
+	}
+
+	if len(result.Added) > 0 {
+		sort.SliceStable(stats.Sessions, func(i, j int) bool {
+			return stats.Sessions[i].Timestamp.Before(stats.Sessions[j].Timestamp)
+		})
+	}
+
+	return result
+}
+
+// sameSession reports whether two records with the same ID hold the same data.
+func sameSession(a, b SessionRecord) bool {
+	return a.Timestamp.Equal(b.Timestamp) &&
+		a.Mode == b.Mode &&
+		a.Difficulty == b.Difficulty &&
+		a.DurationSeconds == b.DurationSeconds &&
+		a.QuestionsAttempted == b.QuestionsAttempted &&
+		a.Score == b.Score &&
+		len(a.Questions) == len(b.Questions)
+}
+
+// String describes the conflicting fields for display.
+func (c MergeConflict) String() string {
+	switch {
+	case !c.Local.Timestamp.Equal(c.Incoming.Timestamp):
+		return fmt.Sprintf("timestamp %s vs %s",
+			c.Local.Timestamp.Format("2006-01-02 15:04"), c.Incoming.Timestamp.Format("2006-01-02 15:04"))
+	case c.Local.Mode != c.Incoming.Mode:
+		return fmt.Sprintf("mode %s vs %s", c.Local.Mode, c.Incoming.Mode)
+	case c.Local.Score != c.Incoming.Score:
+		return fmt.Sprintf("score %d vs %d", c.Local.Score, c.Incoming.Score)
+	default:
+		return "session data differs"
+	}
+}
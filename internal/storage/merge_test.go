@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	base := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	local := &Statistics{Sessions: []SessionRecord{
+		{ID: "a", Timestamp: base, Mode: "Addition", Difficulty: "Easy", Score: 100},
+		{ID: "c", Timestamp: base.Add(2 * time.Hour), Mode: "Addition", Difficulty: "Easy", Score: 300},
+	}}
+
+	incoming := []SessionRecord{
+		{ID: "a", Timestamp: base, Mode: "Addition", Difficulty: "Easy", Score: 100},                    // duplicate
+		{ID: "b", Timestamp: base.Add(time.Hour), Mode: "Division", Difficulty: "Hard", Score: 200},     // new
+		{ID: "c", Timestamp: base.Add(2 * time.Hour), Mode: "Addition", Difficulty: "Easy", Score: 280}, // conflict
+		{ID: "", Timestamp: base, Mode: "Addition", Difficulty: "Easy"},                                 // invalid
+		{ID: "b", Timestamp: base.Add(time.Hour), Mode: "Division", Difficulty: "Hard", Score: 200},     // repeated in file
+	}
+
+	result := Merge(local, incoming)
+
+	if len(result.Added) != 1 || result.Added[0].ID != "b" {
+		t.Errorf("Added = %+v, want [b]", result.Added)
+	}
+	if result.Duplicates != 2 {
+		t.Errorf("Duplicates = %d, want 2", result.Duplicates)
+	}
+	if len(result.Conflicts) != 1 || result.Conflicts[0].Local.Score != 300 {
+		t.Errorf("Conflicts = %+v, want one conflict on c", result.Conflicts)
+	}
+	if len(result.Invalid) != 1 || result.Invalid[0].Index != 4 {
+		t.Errorf("Invalid = %+v, want record #4", result.Invalid)
+	}
+
+	// Chronological order with the new session in the middle
+	wantIDs := []string{"a", "b", "c"}
+	if len(local.Sessions) != len(wantIDs) {
+		t.Fatalf("got %d sessions, want %d", len(local.Sessions), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if local.Sessions[i].ID != id {
+			t.Errorf("Sessions[%d].ID = %q, want %q", i, local.Sessions[i].ID, id)
+		}
+	}
+	if local.Sessions[1].Questions == nil {
+		t.Error("merged Questions should never be nil")
+	}
+	// Local copy wins on conflict
+	if local.Sessions[2].Score != 300 {
+		t.Errorf("conflicting session was replaced: score %d", local.Sessions[2].Score)
+	}
+}
+
+func TestMergeConflictString(t *testing.T) {
+	base := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	c := MergeConflict{
+		Local:    SessionRecord{ID: "x", Timestamp: base, Mode: "Addition", Score: 10},
+		Incoming: SessionRecord{ID: "x", Timestamp: base, Mode: "Addition", Score: 20},
+	}
+	if got := c.String(); got != "score 10 vs 20" {
+		t.Errorf("String() = %q", got)
+	}
+}
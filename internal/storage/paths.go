@@ -8,7 +8,10 @@ import (
 const (
 	configDirName  = "arithmego"
 	statisticsFile = "statistics.json"
+	sqliteFile     = "statistics.db"
 	configFile     = "config.json"
+	statusFile     = "status.json"
+	themesDir      = "themes"
 )
 
 // configDirOverride allows tests to use a temporary directory.
@@ -16,8 +19,10 @@ var configDirOverride string
 
 // SetConfigDirForTesting sets a custom config directory for tests.
 // Pass an empty string to restore default behavior.
+// The active profile is reset to the default profile.
 func SetConfigDirForTesting(path string) {
 	configDirOverride = path
+	activeProfile = ""
 }
 
 // ConfigDir returns the path to the ArithmeGo config directory.
@@ -41,20 +46,48 @@ func ConfigDir() (string, error) {
 	return dir, nil
 }
 
-// StatisticsPath returns the path to the statistics file.
+// StatisticsPath returns the path to the active profile's statistics file.
 func StatisticsPath() (string, error) {
-	dir, err := ConfigDir()
+	dir, err := ProfileDir()
 	if err != nil {
 		return "", err
 	}
 	return filepath.Join(dir, statisticsFile), nil
 }
 
-// ConfigPath returns the path to the config file.
+// ConfigPath returns the path to the active profile's config file.
 func ConfigPath() (string, error) {
-	dir, err := ConfigDir()
+	dir, err := ProfileDir()
 	if err != nil {
 		return "", err
 	}
 	return filepath.Join(dir, configFile), nil
 }
+
+// SQLitePath returns the path to the active profile's SQLite database.
+func SQLitePath() (string, error) {
+	dir, err := ProfileDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, sqliteFile), nil
+}
+
+// StatusPath returns the path to the active profile's status line cache.
+func StatusPath() (string, error) {
+	dir, err := ProfileDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, statusFile), nil
+}
+
+// ThemesDir returns the directory of user theme files. Themes are shared
+// by all profiles. The directory is not created.
+func ThemesDir() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, themesDir), nil
+}
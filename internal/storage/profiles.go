@@ -0,0 +1,295 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// DefaultProfile is the name of the built-in profile. Its data lives directly in
+// the config directory, so histories from before profiles existed keep working.
+const DefaultProfile = "default"
+
+const (
+	profilesDirName   = "profiles"
+	profilesStateFile = "profiles.json"
+)
+
+// maxProfileNameLen is the maximum length of a profile name.
+const maxProfileNameLen = 32
+
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// activeProfile is the profile used for config and statistics paths.
+// Empty means DefaultProfile.
+var activeProfile string
+
+// profilesState is persisted in profiles.json in the config directory.
+type profilesState struct {
+	Active string `json:"active,omitempty"`
+}
+
+// ValidateProfileName checks that name is usable as a new profile name.
+// Names are lowercase letters, digits, '-' and '_', starting with a letter or digit.
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	if len(name) > maxProfileNameLen {
+		return fmt.Errorf("profile name is too long (max %d characters)", maxProfileNameLen)
+	}
+	if name == DefaultProfile {
+		return fmt.Errorf("%q is reserved", DefaultProfile)
+	}
+	if !profileNamePattern.MatchString(name) {
+		return errors.New("profile name may only contain lowercase letters, digits, '-' and '_'")
+	}
+	return nil
+}
+
+// ActiveProfile returns the name of the profile currently in use.
+func ActiveProfile() string {
+	if activeProfile == "" {
+		return DefaultProfile
+	}
+	return activeProfile
+}
+
+// SetActiveProfile switches config and statistics paths to the named profile.
+// Returns an error if the profile does not exist.
+func SetActiveProfile(name string) error {
+	if name == "" || name == DefaultProfile {
+		activeProfile = ""
+		return nil
+	}
+	exists, err := ProfileExists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	activeProfile = name
+	return nil
+}
+
+// ProfileDir returns the data directory of the active profile.
+// Creates the directory if it doesn't exist.
+func ProfileDir() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if activeProfile == "" {
+		return dir, nil
+	}
+
+	dir = filepath.Join(dir, profilesDirName, activeProfile)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// profilePath returns the data directory for a named (non-default) profile.
+func profilePath(name string) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, profilesDirName, name), nil
+}
+
+// ProfileExists reports whether a profile with the given name exists.
+func ProfileExists(name string) (bool, error) {
+	if name == DefaultProfile {
+		return true, nil
+	}
+	if ValidateProfileName(name) != nil {
+		return false, nil
+	}
+	path, err := profilePath(name)
+	if err != nil {
+		return false, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return info.IsDir(), nil
+}
+
+// ListProfiles returns all profile names, with the default profile first
+// and the rest sorted alphabetically.
+func ListProfiles() ([]string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, profilesDirName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() && ValidateProfileName(e.Name()) == nil {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	return append([]string{DefaultProfile}, names...), nil
+}
+
+// CreateProfile creates an empty profile.
+func CreateProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	path, err := profilePath(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	return os.MkdirAll(path, 0700)
+}
+
+// RenameProfile renames a profile, keeping its config and statistics.
+// The default profile cannot be renamed.
+func RenameProfile(oldName, newName string) error {
+	if oldName == DefaultProfile {
+		return errors.New("the default profile cannot be renamed")
+	}
+	if err := ValidateProfileName(newName); err != nil {
+		return err
+	}
+	exists, err := ProfileExists(oldName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("profile %q does not exist", oldName)
+	}
+
+	oldPath, err := profilePath(oldName)
+	if err != nil {
+		return err
+	}
+	newPath, err := profilePath(newName)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("profile %q already exists", newName)
+	}
+	selected, _ := LoadSelectedProfile()
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return err
+	}
+
+	// Keep the active and saved selections pointing at the renamed profile.
+	if activeProfile == oldName {
+		activeProfile = newName
+	}
+	if selected == oldName {
+		return SaveSelectedProfile(newName)
+	}
+	return nil
+}
+
+// CanDeleteProfile returns an error if the profile cannot be deleted.
+// The default profile and the active profile cannot be deleted.
+func CanDeleteProfile(name string) error {
+	if name == DefaultProfile {
+		return errors.New("the default profile cannot be deleted")
+	}
+	if name == activeProfile {
+		return fmt.Errorf("profile %q is in use; switch to another profile first", name)
+	}
+	exists, err := ProfileExists(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	return nil
+}
+
+// DeleteProfile removes a profile and all of its data.
+func DeleteProfile(name string) error {
+	if err := CanDeleteProfile(name); err != nil {
+		return err
+	}
+
+	path, err := profilePath(name)
+	if err != nil {
+		return err
+	}
+	selected, _ := LoadSelectedProfile()
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+
+	if selected == name {
+		return SaveSelectedProfile(DefaultProfile)
+	}
+	return nil
+}
+
+// LoadSelectedProfile returns the profile chosen in the last session.
+// Returns DefaultProfile if none was saved or the saved profile no longer exists.
+func LoadSelectedProfile() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return DefaultProfile, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, profilesStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultProfile, nil
+		}
+		return DefaultProfile, err
+	}
+
+	var state profilesState
+	if err := json.Unmarshal(data, &state); err != nil || state.Active == "" {
+		// Selection is non-critical; fall back to the default profile.
+		return DefaultProfile, nil
+	}
+
+	if exists, _ := ProfileExists(state.Active); !exists {
+		return DefaultProfile, nil
+	}
+	return state.Active, nil
+}
+
+// SaveSelectedProfile remembers the profile to use when no --profile is given.
+func SaveSelectedProfile(name string) error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+
+	state := profilesState{}
+	if name != DefaultProfile {
+		state.Active = name
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, profilesStateFile), data, 0600)
+}
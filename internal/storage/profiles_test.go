@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateProfileName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"kid", false},
+		{"alice-2", false},
+		{"work_laptop", false},
+		{"", true},
+		{"default", true},
+		{"Alice", true},
+		{"-kid", true},
+		{"has space", true},
+		{"../escape", true},
+		{"abcdefghijklmnopqrstuvwxyz0123456", true}, // 33 chars
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateProfileName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateProfileName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProfiles_Lifecycle(t *testing.T) {
+	SetConfigDirForTesting(t.TempDir())
+	defer SetConfigDirForTesting("")
+
+	if err := CreateProfile("kid"); err != nil {
+		t.Fatalf("CreateProfile() error = %v", err)
+	}
+	if err := CreateProfile("alice"); err != nil {
+		t.Fatalf("CreateProfile() error = %v", err)
+	}
+	if err := CreateProfile("kid"); err == nil {
+		t.Error("CreateProfile() of existing profile should fail")
+	}
+
+	names, err := ListProfiles()
+	if err != nil {
+		t.Fatalf("ListProfiles() error = %v", err)
+	}
+	want := []string{DefaultProfile, "alice", "kid"}
+	if len(names) != len(want) {
+		t.Fatalf("ListProfiles() = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("ListProfiles()[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	if err := RenameProfile("kid", "sam"); err != nil {
+		t.Fatalf("RenameProfile() error = %v", err)
+	}
+	if exists, _ := ProfileExists("kid"); exists {
+		t.Error("old profile name should no longer exist")
+	}
+	if exists, _ := ProfileExists("sam"); !exists {
+		t.Error("renamed profile should exist")
+	}
+	if err := RenameProfile(DefaultProfile, "other"); err == nil {
+		t.Error("renaming the default profile should fail")
+	}
+
+	if err := DeleteProfile(DefaultProfile); err == nil {
+		t.Error("deleting the default profile should fail")
+	}
+	if err := SetActiveProfile("sam"); err != nil {
+		t.Fatalf("SetActiveProfile() error = %v", err)
+	}
+	if err := DeleteProfile("sam"); err == nil {
+		t.Error("deleting the active profile should fail")
+	}
+	if err := SetActiveProfile(DefaultProfile); err != nil {
+		t.Fatalf("SetActiveProfile() error = %v", err)
+	}
+	if err := DeleteProfile("sam"); err != nil {
+		t.Fatalf("DeleteProfile() error = %v", err)
+	}
+	if exists, _ := ProfileExists("sam"); exists {
+		t.Error("deleted profile should not exist")
+	}
+}
+
+func TestProfiles_SeparateData(t *testing.T) {
+	dir := t.TempDir()
+	SetConfigDirForTesting(dir)
+	defer SetConfigDirForTesting("")
+
+	// Default profile data stays at the top level of the config directory.
+	path, err := StatisticsPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != filepath.Join(dir, statisticsFile) {
+		t.Errorf("default StatisticsPath() = %q, want top-level file", path)
+	}
+
+	record, _ := NewSessionRecord("Addition", "Easy", 60)
+	if err := AddSession(record); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CreateProfile("kid"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetActiveProfile("kid"); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err = StatisticsPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != filepath.Join(dir, profilesDirName, "kid", statisticsFile) {
+		t.Errorf("profile StatisticsPath() = %q", path)
+	}
+
+	stats, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Sessions) != 0 {
+		t.Errorf("new profile has %d sessions, want 0", len(stats.Sessions))
+	}
+
+	if err := SetActiveProfile("missing"); err == nil {
+		t.Error("SetActiveProfile() of missing profile should fail")
+	}
+}
+
+func TestSelectedProfile(t *testing.T) {
+	dir := t.TempDir()
+	SetConfigDirForTesting(dir)
+	defer SetConfigDirForTesting("")
+
+	name, err := LoadSelectedProfile()
+	if err != nil || name != DefaultProfile {
+		t.Errorf("LoadSelectedProfile() = %q, %v; want default", name, err)
+	}
+
+	if err := CreateProfile("kid"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveSelectedProfile("kid"); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := LoadSelectedProfile(); name != "kid" {
+		t.Errorf("LoadSelectedProfile() = %q, want kid", name)
+	}
+
+	// Renaming follows the saved selection.
+	if err := RenameProfile("kid", "sam"); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := LoadSelectedProfile(); name != "sam" {
+		t.Errorf("LoadSelectedProfile() after rename = %q, want sam", name)
+	}
+
+	// A selection pointing at a removed profile falls back to default.
+	if err := os.RemoveAll(filepath.Join(dir, profilesDirName, "sam")); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := LoadSelectedProfile(); name != DefaultProfile {
+		t.Errorf("LoadSelectedProfile() for missing profile = %q, want default", name)
+	}
+}
@@ -0,0 +1,273 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxBackups is the number of rotating statistics backups kept on disk.
+// statistics.json.1 is the most recent, statistics.json.3 the oldest.
+const maxBackups = 3
+
+// saveRecovered writes the recovered statistics. Tests replace it to make
+// the save fail.
+var saveRecovered = Save
+
+// RecoveryReport describes what happened when a damaged statistics file was recovered.
+type RecoveryReport struct {
+	// QuarantinePath is where the damaged file was moved to.
+	QuarantinePath string
+
+	// Salvaged is the number of sessions parsed out of the damaged file.
+	Salvaged int
+
+	// FromBackup is the number of additional sessions restored from backups.
+	FromBackup int
+
+	// BackupPath is the backup that sessions were restored from (empty if none).
+	BackupPath string
+
+	// ParseError is the original error that triggered recovery.
+	ParseError error
+}
+
+// Total returns the total number of sessions recovered.
+func (r RecoveryReport) Total() int {
+	return r.Salvaged + r.FromBackup
+}
+
+// Validate checks that a session record has the fields required for analytics.
+func (s SessionRecord) Validate() error {
+	if s.ID == "" {
+		return errors.New("missing id")
+	}
+	if s.Mode == "" {
+		return errors.New("missing mode")
+	}
+	if s.Difficulty == "" {
+		return errors.New("missing difficulty")
+	}
+	if s.Timestamp.IsZero() {
+		return errors.New("missing timestamp")
+	}
+	if s.DurationSeconds < 0 {
+		return errors.New("negative duration")
+	}
+	return nil
+}
+
+// backupPath returns the path of the nth rotating backup (1-based).
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d", path, n)
+}
+
+// rotateBackups shifts existing backups down by one and copies the current
+// file at path into the first backup slot. The current file is left in place
+// so readers never observe a missing statistics file. Missing files are ignored.
+func rotateBackups(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	_ = os.Remove(backupPath(path, maxBackups))
+	for i := maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(path, i), backupPath(path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.WriteFile(backupPath(path, 1), data, 0600)
+}
+
+// quarantine moves a damaged file aside so it is never overwritten or rotated
+// into the backups. Returns the new path.
+func quarantine(path string) (string, error) {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	dest := fmt.Sprintf("%s.corrupt-%s%s", base, time.Now().Format("20060102-150405"), ext)
+	if err := os.Rename(path, dest); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// LoadWithRecovery reads statistics like [Load], but recovers from a damaged file
+// instead of failing. The damaged file is quarantined, sessions are salvaged from
+// it and merged with the newest readable backup, and the result is saved.
+// If the save fails the damaged file is moved back, so nothing is lost and the
+// next load tries again. The returned report is nil when no recovery was needed.
+func LoadWithRecovery() (*Statistics, *RecoveryReport, error) {
+	stats, err := Load()
+	if err == nil {
+		return stats, nil, nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+		// Not a parse error (e.g. permissions) - nothing to recover.
+		return nil, nil, err
+	}
+
+	path, pathErr := StatisticsPath()
+	if pathErr != nil {
+		return nil, nil, pathErr
+	}
+
+	data, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return nil, nil, readErr
+	}
+
+	report := &RecoveryReport{ParseError: err}
+	recovered := SalvageSessions(data)
+	report.Salvaged = len(recovered)
+
+	// Merge in sessions from the newest backup that still parses.
+	seen := make(map[string]bool, len(recovered))
+	for _, s := range recovered {
+		seen[s.ID] = true
+	}
+	for i := 1; i <= maxBackups; i++ {
+		backup, ok := readBackup(backupPath(path, i))
+		if !ok {
+			continue
+		}
+		for _, s := range backup.Sessions {
+			if seen[s.ID] || s.Validate() != nil {
+				continue
+			}
+			seen[s.ID] = true
+			recovered = append(recovered, s)
+			report.FromBackup++
+		}
+		report.BackupPath = backupPath(path, i)
+		break
+	}
+
+	quarantined, qErr := quarantine(path)
+	if qErr != nil {
+		return nil, nil, qErr
+	}
+	report.QuarantinePath = quarantined
+
+	sort.SliceStable(recovered, func(i, j int) bool {
+		return recovered[i].Timestamp.Before(recovered[j].Timestamp)
+	})
+
+	stats = &Statistics{Sessions: recovered}
+	if err := saveRecovered(stats); err != nil {
+		if rErr := os.Rename(quarantined, path); rErr != nil {
+			return nil, report, errors.Join(err, rErr)
+		}
+		report.QuarantinePath = ""
+		return nil, report, err
+	}
+	return stats, report, nil
+}
+
+// readBackup parses a backup file. Returns false if it is missing or unreadable.
+func readBackup(path string) (*Statistics, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var stats Statistics
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, false
+	}
+	return &stats, true
+}
+
+// SalvageSessions extracts every intact session object from damaged statistics
+// JSON. It scans the "sessions" array for balanced objects and keeps those that
+// decode and validate, so a truncated or garbled entry only loses itself.
+func SalvageSessions(data []byte) []SessionRecord {
+	start := findSessionsArray(data)
+	if start < 0 {
+		return []SessionRecord{}
+	}
+
+	sessions := []SessionRecord{}
+	depth := 0
+	inString := false
+	escaped := false
+	objStart := -1
+
+	for i := start; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			if depth == 0 {
+				objStart = i
+			}
+			depth++
+		case '}':
+			if depth == 0 {
+				continue
+			}
+			depth--
+			if depth == 0 && objStart >= 0 {
+				var s SessionRecord
+				if err := json.Unmarshal(data[objStart:i+1], &s); err == nil && s.Validate() == nil {
+					if s.Questions == nil {
+						s.Questions = []QuestionRecord{}
+					}
+					sessions = append(sessions, s)
+				}
+				objStart = -1
+			}
+		case ']':
+			if depth == 0 {
+				return sessions
+			}
+		}
+	}
+
+	return sessions
+}
+
+// findSessionsArray returns the index just past the '[' that opens the
+// "sessions" array, or -1 if it cannot be found.
+func findSessionsArray(data []byte) int {
+	key := []byte(`"sessions"`)
+	idx := bytes.Index(data, key)
+	if idx < 0 {
+		return -1
+	}
+	for i := idx + len(key); i < len(data); i++ {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r', ':':
+			continue
+		case '[':
+			return i + 1
+		default:
+			return -1
+		}
+	}
+	return -1
+}
@@ -0,0 +1,264 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSave_RotatesBackups(t *testing.T) {
+	tempDir := t.TempDir()
+	SetConfigDirForTesting(tempDir)
+	defer SetConfigDirForTesting("")
+
+	path, err := StatisticsPath()
+	if err != nil {
+		t.Fatalf("StatisticsPath() error = %v", err)
+	}
+
+	// Five saves should leave the current file plus maxBackups backups
+	for i := 0; i < 5; i++ {
+		record, _ := NewSessionRecord("Addition", "Easy", 60)
+		if err := AddSession(record); err != nil {
+			t.Fatalf("AddSession() error = %v", err)
+		}
+	}
+
+	for i := 1; i <= maxBackups; i++ {
+		if _, err := os.Stat(backupPath(path, i)); err != nil {
+			t.Errorf("backup %d should exist: %v", i, err)
+		}
+	}
+	if _, err := os.Stat(backupPath(path, maxBackups+1)); !os.IsNotExist(err) {
+		t.Errorf("backup %d should not exist", maxBackups+1)
+	}
+
+	// Newest backup holds the state before the last save
+	backup, ok := readBackup(backupPath(path, 1))
+	if !ok {
+		t.Fatal("newest backup should parse")
+	}
+	if len(backup.Sessions) != 4 {
+		t.Errorf("newest backup has %d sessions, want 4", len(backup.Sessions))
+	}
+}
+
+func TestSalvageSessions(t *testing.T) {
+	data := []byte(`{"sessions": [
+		{"id": "a", "timestamp": "2025-01-01T10:00:00Z", "mode": "Addition", "difficulty": "Easy", "score": 100},
+		{"id": "b", "timestamp": "2025-01-02T10:00:00Z", "mode": "Addition {x}", "difficulty": "Easy", "questions": [{"question": "1 + 1"}]},
+		{"id": "c", "timestamp": "not a time", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "", "timestamp": "2025-01-03T10:00:00Z", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "d", "timestamp": "2025-01-04T10:00:00Z", "mode": "Addi`)
+
+	sessions := SalvageSessions(data)
+	if len(sessions) != 2 {
+		t.Fatalf("SalvageSessions() returned %d sessions, want 2", len(sessions))
+	}
+	if sessions[0].ID != "a" || sessions[1].ID != "b" {
+		t.Errorf("unexpected sessions: %q, %q", sessions[0].ID, sessions[1].ID)
+	}
+	if sessions[0].Questions == nil {
+		t.Error("salvaged Questions should never be nil")
+	}
+	if len(sessions[1].Questions) != 1 {
+		t.Errorf("session b should keep its questions, got %d", len(sessions[1].Questions))
+	}
+}
+
+func TestSalvageSessions_NoSessionsArray(t *testing.T) {
+	if got := SalvageSessions([]byte(`garbage`)); len(got) != 0 {
+		t.Errorf("expected no sessions, got %d", len(got))
+	}
+}
+
+func TestLoadWithRecovery_NoRecoveryNeeded(t *testing.T) {
+	tempDir := t.TempDir()
+	SetConfigDirForTesting(tempDir)
+	defer SetConfigDirForTesting("")
+
+	stats, report, err := LoadWithRecovery()
+	if err != nil {
+		t.Fatalf("LoadWithRecovery() error = %v", err)
+	}
+	if report != nil {
+		t.Error("report should be nil when the file is healthy")
+	}
+	if stats == nil || stats.Sessions == nil {
+		t.Error("stats should be empty, not nil")
+	}
+}
+
+func TestLoadWithRecovery_CorruptedFile(t *testing.T) {
+	tempDir := t.TempDir()
+	SetConfigDirForTesting(tempDir)
+	defer SetConfigDirForTesting("")
+
+	path, err := StatisticsPath()
+	if err != nil {
+		t.Fatalf("StatisticsPath() error = %v", err)
+	}
+
+	// Backup contains one session that is also in the damaged file, and one that is not
+	backup := []byte(`{"sessions": [
+		{"id": "old", "timestamp": "2025-01-01T10:00:00Z", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "kept", "timestamp": "2025-01-02T10:00:00Z", "mode": "Addition", "difficulty": "Easy"}
+	]}`)
+	if err := os.WriteFile(backupPath(path, 1), backup, 0600); err != nil {
+		t.Fatalf("write backup: %v", err)
+	}
+
+	damaged := []byte(`{"sessions": [
+		{"id": "kept", "timestamp": "2025-01-02T10:00:00Z", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "new", "timestamp": "2025-01-03T10:00:00Z", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "trunc`)
+	if err := os.WriteFile(path, damaged, 0600); err != nil {
+		t.Fatalf("write damaged: %v", err)
+	}
+
+	stats, report, err := LoadWithRecovery()
+	if err != nil {
+		t.Fatalf("LoadWithRecovery() error = %v", err)
+	}
+	if report == nil {
+		t.Fatal("report should be set after recovery")
+	}
+	if report.Salvaged != 2 {
+		t.Errorf("Salvaged = %d, want 2", report.Salvaged)
+	}
+	if report.FromBackup != 1 {
+		t.Errorf("FromBackup = %d, want 1", report.FromBackup)
+	}
+	if report.Total() != 3 {
+		t.Errorf("Total() = %d, want 3", report.Total())
+	}
+
+	// Sessions are merged and ordered by timestamp
+	wantIDs := []string{"old", "kept", "new"}
+	if len(stats.Sessions) != len(wantIDs) {
+		t.Fatalf("got %d sessions, want %d", len(stats.Sessions), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if stats.Sessions[i].ID != id {
+			t.Errorf("Sessions[%d].ID = %q, want %q", i, stats.Sessions[i].ID, id)
+		}
+	}
+
+	// Damaged file is quarantined with its original content
+	quarantined, err := os.ReadFile(report.QuarantinePath)
+	if err != nil {
+		t.Fatalf("quarantined file should exist: %v", err)
+	}
+	if string(quarantined) != string(damaged) {
+		t.Error("quarantined file should keep the damaged content")
+	}
+	if filepath.Dir(report.QuarantinePath) != tempDir {
+		t.Errorf("quarantine should stay in the config dir, got %s", report.QuarantinePath)
+	}
+
+	// The repaired file now loads cleanly
+	reloaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() after recovery error = %v", err)
+	}
+	if len(reloaded.Sessions) != 3 {
+		t.Errorf("reloaded %d sessions, want 3", len(reloaded.Sessions))
+	}
+}
+
+func TestLoadWithRecovery_SaveFailsKeepsOriginal(t *testing.T) {
+	SetConfigDirForTesting(t.TempDir())
+	defer SetConfigDirForTesting("")
+
+	saveRecovered = func(*Statistics) error { return errors.New("disk full") }
+	defer func() { saveRecovered = Save }()
+
+	path, err := StatisticsPath()
+	if err != nil {
+		t.Fatalf("StatisticsPath() error = %v", err)
+	}
+	damaged := []byte(`{"sessions": [
+		{"id": "kept", "timestamp": "2025-01-02T10:00:00Z", "mode": "Addition", "difficulty": "Easy"},
+		{"id": "trunc`)
+	if err := os.WriteFile(path, damaged, 0600); err != nil {
+		t.Fatalf("write damaged: %v", err)
+	}
+
+	if _, _, err := LoadWithRecovery(); err == nil {
+		t.Fatal("LoadWithRecovery() should report the failed save")
+	}
+
+	// The damaged file is back in place for the next attempt
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("damaged file should be restored: %v", err)
+	}
+	if string(data) != string(damaged) {
+		t.Error("restored file should keep the damaged content")
+	}
+
+	saveRecovered = Save
+	stats, report, err := LoadWithRecovery()
+	if err != nil {
+		t.Fatalf("LoadWithRecovery() retry error = %v", err)
+	}
+	if report == nil || len(stats.Sessions) != 1 {
+		t.Errorf("retry recovered %v, want 1 session", stats)
+	}
+}
+
+func TestAddSession_RecoversDamagedFile(t *testing.T) {
+	tempDir := t.TempDir()
+	SetConfigDirForTesting(tempDir)
+	defer SetConfigDirForTesting("")
+
+	path, err := StatisticsPath()
+	if err != nil {
+		t.Fatalf("StatisticsPath() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"sessions": [{"id": `), 0600); err != nil {
+		t.Fatalf("write damaged: %v", err)
+	}
+
+	record, _ := NewSessionRecord("Addition", "Easy", 60)
+	if err := AddSession(record); err != nil {
+		t.Fatalf("AddSession() should recover, got %v", err)
+	}
+
+	stats, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(stats.Sessions) != 1 || stats.Sessions[0].ID != record.ID {
+		t.Error("new session should be saved after recovery")
+	}
+}
+
+func TestSessionRecordValidate(t *testing.T) {
+	valid := SessionRecord{ID: "x", Mode: "Addition", Difficulty: "Easy", Timestamp: time.Now()}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*SessionRecord)
+	}{
+		{"missing id", func(s *SessionRecord) { s.ID = "" }},
+		{"missing mode", func(s *SessionRecord) { s.Mode = "" }},
+		{"missing difficulty", func(s *SessionRecord) { s.Difficulty = "" }},
+		{"missing timestamp", func(s *SessionRecord) { s.Timestamp = time.Time{} }},
+		{"negative duration", func(s *SessionRecord) { s.DurationSeconds = -1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.modify(&s)
+			if err := s.Validate(); err == nil {
+				t.Error("Validate() should return an error")
+			}
+		})
+	}
+}
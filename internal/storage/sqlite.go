@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	// Pure-Go SQLite driver, registered as "sqlite".
+	_ "modernc.org/sqlite"
+)
+
+// sqliteSchema creates the sessions table. Filterable fields are stored in
+// their own indexed columns; the full record is kept as JSON in data so new
+// record fields need no schema change.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS sessions (
+	id         TEXT PRIMARY KEY,
+	timestamp  INTEGER NOT NULL,
+	mode       TEXT NOT NULL,
+	difficulty TEXT NOT NULL,
+	data       TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS sessions_timestamp ON sessions (timestamp);
+CREATE INDEX IF NOT EXISTS sessions_mode ON sessions (mode, difficulty);
+`
+
+// sqliteBackend stores sessions in statistics.db.
+type sqliteBackend struct {
+	db *sql.DB
+}
+
+// openSQLite opens (and if needed creates) the active profile's database.
+func openSQLite() (*sqliteBackend, error) {
+	path, err := SQLitePath()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+
+	return &sqliteBackend{db: db}, nil
+}
+
+func (b *sqliteBackend) Name() string { return BackendSQLite }
+
+func (b *sqliteBackend) Load() (*Statistics, error) {
+	return b.Query(SessionQuery{})
+}
+
+// Query pushes the session filters down into the WHERE clause.
+func (b *sqliteBackend) Query(q SessionQuery) (*Statistics, error) {
+	var where []string
+	var args []any
+	if !q.Since.IsZero() {
+		where = append(where, "timestamp >= ?")
+		args = append(args, timestampKey(q.Since))
+	}
+	if q.Difficulty != "" {
+		where = append(where, "difficulty = ?")
+		args = append(args, q.Difficulty)
+	}
+	if q.Mode != "" {
+		where = append(where, "mode = ?")
+		args = append(args, q.Mode)
+	}
+
+	query := "SELECT data FROM sessions"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY timestamp, id"
+
+	rows, err := b.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := &Statistics{Sessions: []SessionRecord{}}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var s SessionRecord
+		if err := json.Unmarshal([]byte(data), &s); err != nil {
+			return nil, err
+		}
+		stats.Sessions = append(stats.Sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (b *sqliteBackend) AddSession(record SessionRecord) error {
+	return insertSession(b.db, record)
+}
+
+// Save replaces all sessions in a single transaction.
+func (b *sqliteBackend) Save(stats *Statistics) error {
+	tx, err := b.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.Exec("DELETE FROM sessions"); err != nil {
+		return err
+	}
+	for _, s := range stats.Sessions {
+		if err := insertSession(tx, s); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Check runs SQLite's quick integrity check.
+func (b *sqliteBackend) Check() error {
+	var result string
+	if err := b.db.QueryRow("PRAGMA quick_check").Scan(&result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("statistics database is damaged: %s", result)
+	}
+	return nil
+}
+
+func (b *sqliteBackend) Close() error {
+	return b.db.Close()
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// insertSession writes one session row. An existing row with the same ID is replaced.
+func insertSession(e execer, s SessionRecord) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = e.Exec(
+		"INSERT OR REPLACE INTO sessions (id, timestamp, mode, difficulty, data) VALUES (?, ?, ?, ?, ?)",
+		s.ID, timestampKey(s.Timestamp), s.Mode, s.Difficulty, string(data),
+	)
+	return err
+}
+
+// timestampKey converts a timestamp to the sortable integer stored in the database.
+func timestampKey(t time.Time) int64 {
+	return t.UnixNano()
+}
@@ -13,13 +13,20 @@ import (
 // QuestionRecord stores data for a single answered question.
 type QuestionRecord struct {
 	Question       string `json:"question"`
+	Key            string `json:"key,omitempty"` // Canonical form of the question; empty in older records
 	Operation      string `json:"operation"`
 	CorrectAnswer  int    `json:"correct_answer"`
 	UserAnswer     int    `json:"user_answer"`
 	Correct        bool   `json:"correct"`
 	Skipped        bool   `json:"skipped"`
+	TimedOut       bool   `json:"timed_out,omitempty"` // Skipped because the question timer ran out
 	ResponseTimeMs int64  `json:"response_time_ms"`
 	PointsEarned   int    `json:"points_earned"`
+	OffsetMs       int64  `json:"offset_ms,omitempty"` // Game time from session start; 0 in older records
+
+	// Flash Anzan questions: how many numbers were flashed, and for how long each
+	FlashLength     int   `json:"flash_length,omitempty"`
+	FlashIntervalMs int64 `json:"flash_interval_ms,omitempty"`
 }
 
 // SessionRecord stores data for a completed game session.
@@ -36,9 +43,35 @@ type SessionRecord struct {
 	Score              int              `json:"score"`
 	BestStreak         int              `json:"best_streak"`
 	AvgResponseTimeMs  int64            `json:"avg_response_time_ms"`
+	QuestionLimitMs    int64            `json:"question_limit_ms,omitempty"` // Question timer countdown; 0 when it was off
 	Questions          []QuestionRecord `json:"questions"`
 }
 
+// AnswerOffsets returns, for each question, the game time from session
+// start to its answer. Records written before offsets were stored fall back
+// to adding up response times, which ignores time spent on feedback.
+func (s SessionRecord) AnswerOffsets() []time.Duration {
+	hasOffsets := false
+	for _, q := range s.Questions {
+		if q.OffsetMs > 0 {
+			hasOffsets = true
+			break
+		}
+	}
+
+	offsets := make([]time.Duration, len(s.Questions))
+	var elapsedMs int64
+	for i, q := range s.Questions {
+		elapsedMs += q.ResponseTimeMs
+		offsetMs := q.OffsetMs
+		if !hasOffsets {
+			offsetMs = elapsedMs
+		}
+		offsets[i] = time.Duration(offsetMs) * time.Millisecond
+	}
+	return offsets
+}
+
 // Statistics holds all recorded sessions.
 type Statistics struct {
 	Sessions []SessionRecord `json:"sessions"`
@@ -98,6 +131,7 @@ func Load() (*Statistics, error) {
 
 // Save writes statistics to the JSON file using atomic write.
 // Writes to a temp file first, then renames to prevent corruption.
+// The previous file is kept as a rotating backup (statistics.json.1 to .3).
 func Save(stats *Statistics) error {
 	path, err := StatisticsPath()
 	if err != nil {
@@ -152,6 +186,10 @@ func Save(stats *Statistics) error {
 		return err
 	}
 
+	// Keep rotating backups of the previous file for recovery.
+	// Backup failures must not block saving new data.
+	_ = rotateBackups(path)
+
 	// Atomic rename (works because temp file is in same directory as target)
 	if err := os.Rename(tmpPath, path); err != nil {
 		return err
@@ -162,8 +200,9 @@ func Save(stats *Statistics) error {
 }
 
 // AddSession appends a session and saves to disk.
+// A damaged statistics file is recovered first so the new session is not lost.
 func AddSession(record SessionRecord) error {
-	stats, err := Load()
+	stats, _, err := LoadWithRecovery()
 	if err != nil {
 		return err
 	}
@@ -191,3 +191,23 @@ func TestConfigDir(t *testing.T) {
 		t.Error("Expected a directory")
 	}
 }
+
+func TestAnswerOffsets(t *testing.T) {
+	record := SessionRecord{Questions: []QuestionRecord{
+		{ResponseTimeMs: 1500, OffsetMs: 1600},
+		{ResponseTimeMs: 2000, OffsetMs: 4000},
+	}}
+	got := record.AnswerOffsets()
+	if len(got) != 2 || got[0] != 1600*time.Millisecond || got[1] != 4*time.Second {
+		t.Errorf("AnswerOffsets() = %v, want [1.6s 4s]", got)
+	}
+
+	// Older records without offsets add up response times.
+	for i := range record.Questions {
+		record.Questions[i].OffsetMs = 0
+	}
+	got = record.AnswerOffsets()
+	if len(got) != 2 || got[0] != 1500*time.Millisecond || got[1] != 3500*time.Millisecond {
+		t.Errorf("AnswerOffsets() without offsets = %v, want [1.5s 3.5s]", got)
+	}
+}
@@ -1,16 +1,21 @@
 package ui
 
 import (
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
 	"github.com/gurselcakar/arithmego/internal/game/gen"
+	"github.com/gurselcakar/arithmego/internal/history"
 	"github.com/gurselcakar/arithmego/internal/modes"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/screens"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
 	"github.com/gurselcakar/arithmego/internal/update"
 )
 
@@ -33,6 +38,8 @@ type App struct {
 	onboardingModel  screens.OnboardingModel
 	quitConfirmModel screens.QuitConfirmModel
 	featureTourModel screens.FeatureTourModel
+	profilesModel    screens.ProfileSwitchModel
+	retryModel       screens.RetryModel
 
 	// Current session state
 	session         *game.Session
@@ -40,12 +47,15 @@ type App struct {
 	lastDifficulty  game.Difficulty
 	lastDuration    time.Duration
 	lastInputMethod components.InputMethod
+	ghost           *game.Ghost // Best earlier run of the current setup (nil if none)
 
 	// User config (for Quick Play and defaults)
 	config *storage.Config
 
 	// Error tracking
 	lastSaveError error
+	keymapError   error // Why the configured keymap was not loaded (nil if it was)
+	themeError    error // Why the configured theme was not loaded (nil if it was)
 
 	// CLI start mode flags
 	cliStartMode StartMode
@@ -70,6 +80,13 @@ func NewWithStartMode(startMode StartMode) *App {
 	if config == nil {
 		config = storage.NewConfig()
 	}
+	// An unknown or broken theme falls back to the default, and so does a
+	// conflicting keymap; the menu shows why
+	themeErr := styles.Apply(config.Theme)
+	keymapErr := keys.Apply(config.Keymap, config.KeyBindings)
+	styles.SetAccessible(config.Accessible)
+	_ = expr.Apply(config.Notation)
+	gen.SetFlashOptions(config.FlashLength, time.Duration(config.FlashIntervalMs)*time.Millisecond)
 
 	// Load practice settings from config
 	var practiceSettings *screens.PracticeSettings
@@ -89,8 +106,13 @@ func NewWithStartMode(startMode StartMode) *App {
 		settingsModel:   screens.NewSettings(config),
 		onboardingModel: screens.NewOnboarding(),
 		config:          config,
+		keymapError:     keymapErr,
+		themeError:      themeErr,
 	}
 
+	app.applyMenuProfile()
+	app.applyMenuWarning()
+
 	// Determine starting screen based on start mode
 	switch startMode {
 	case StartModePlayBrowse:
@@ -103,6 +125,11 @@ func NewWithStartMode(startMode StartMode) *App {
 		app.screen = ScreenMenu
 		app.cliStartMode = StartModePlayConfig
 
+	case StartModeGame:
+		// Game with specific mode - will be started in Init()
+		app.screen = ScreenMenu
+		app.cliStartMode = StartModeGame
+
 	case StartModeStatistics:
 		app.screen = ScreenStatistics
 
@@ -143,7 +170,12 @@ func (a *App) Init() tea.Cmd {
 	case StartModePlayConfig:
 		a.cliStartMode = StartModeMenu // Reset flag
 		cmds = append(cmds, func() tea.Msg {
-			return cliPlayConfigMsg{modeID: CLIModeID}
+			return cliPlayConfigMsg{modeID: CLIModeID, overrides: CLIPlayOverrides}
+		})
+	case StartModeGame:
+		a.cliStartMode = StartModeMenu // Reset flag
+		cmds = append(cmds, func() tea.Msg {
+			return cliStartGameMsg{modeID: CLIModeID, overrides: CLIPlayOverrides}
 		})
 	}
 
@@ -181,7 +213,14 @@ type cliPlayBrowseMsg struct{}
 
 // cliPlayConfigMsg triggers play config with a specific mode from CLI.
 type cliPlayConfigMsg struct {
-	modeID string
+	modeID    string
+	overrides screens.PlayOverrides
+}
+
+// cliStartGameMsg starts a game with a specific mode from CLI.
+type cliStartGameMsg struct {
+	modeID    string
+	overrides screens.PlayOverrides
 }
 
 // updateCheckResultMsg carries the result of an update check.
@@ -196,6 +235,10 @@ var Version = "dev"
 // CLIModeID is the mode ID specified via CLI, set before starting the TUI.
 var CLIModeID = ""
 
+// CLIPlayOverrides holds play settings given as CLI flags, set before
+// starting the TUI with StartModePlayConfig or StartModeGame.
+var CLIPlayOverrides screens.PlayOverrides
+
 // autoUpdateResultMsg carries the result of an auto-update attempt.
 type autoUpdateResultMsg struct {
 	version string
@@ -233,7 +276,15 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Handle CLI play config trigger
 	if configMsg, ok := msg.(cliPlayConfigMsg); ok {
-		return a.startPlayConfig(configMsg.modeID)
+		model, cmd := a.startPlayConfig(configMsg.modeID)
+		if a.screen == ScreenPlayConfig {
+			a.playConfigModel.ApplyOverrides(configMsg.overrides)
+		}
+		return model, cmd
+	}
+
+	if startMsg, ok := msg.(cliStartGameMsg); ok {
+		return a.startGameFromCLI(startMsg.modeID, startMsg.overrides)
 	}
 
 	// Handle update check result
@@ -287,6 +338,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a.updateQuitConfirm(msg)
 	case ScreenFeatureTour:
 		return a.updateFeatureTour(msg)
+	case ScreenProfiles:
+		return a.updateProfiles(msg)
+	case ScreenRetry:
+		return a.updateRetry(msg)
 	}
 
 	return a, nil
@@ -330,6 +385,11 @@ func (a *App) updateMenu(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.settingsModel.SetSize(a.width, a.height)
 			a.screen = ScreenSettings
 			return a, a.settingsModel.Init()
+		case screens.ActionProfile:
+			a.profilesModel = screens.NewProfileSwitch()
+			a.profilesModel.SetSize(a.width, a.height)
+			a.screen = ScreenProfiles
+			return a, a.profilesModel.Init()
 		case screens.ActionX:
 			return a, openURL("https://x.com/gurselcakar")
 		}
@@ -402,17 +462,7 @@ func (a *App) updateGame(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// and animation loops by not returning TickCmd/ScoreAnimCmd. Any stale
 	// tick messages will be ignored since we transition to ScreenResults.
 	if gom, ok := msg.(screens.GameOverMsg); ok {
-		a.session = gom.Session
-		a.saveSession()
-		// Use first game results if this is the onboarding game
-		if a.isFirstGame {
-			a.resultsModel = screens.NewResultsFirstGame(a.session, a.lastSaveError)
-		} else {
-			a.resultsModel = screens.NewResults(a.session, a.lastSaveError)
-		}
-		a.resultsModel.SetSize(a.width, a.height)
-		a.screen = ScreenResults
-		return a, a.resultsModel.Init()
+		return a, a.gameOver(gom.Session)
 	}
 
 	// Check for pause
@@ -448,6 +498,33 @@ func (a *App) updateGame(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return a, cmd
 }
 
+// gameOver saves a finished session and shows its results.
+func (a *App) gameOver(session *game.Session) tea.Cmd {
+	a.session = session
+	a.saveSession()
+	// Use first game results if this is the onboarding game
+	if a.isFirstGame {
+		a.resultsModel = screens.NewResultsFirstGame(a.session, a.lastSaveError)
+	} else {
+		a.resultsModel = screens.NewResults(a.session, a.lastSaveError)
+	}
+	a.resultsModel.SetGhost(a.ghost)
+	a.resultsModel.SetSize(a.width, a.height)
+	a.screen = ScreenResults
+	return a.resultsModel.Init()
+}
+
+// EndGame ends a game in progress, running or paused, as if its time had
+// run out: the session is saved and its results shown. Outside a game it
+// does nothing.
+func (a *App) EndGame() {
+	if a.session == nil || (a.screen != ScreenGame && a.screen != ScreenPause) {
+		return
+	}
+	a.session.TimeLeft = 0
+	a.gameOver(a.session)
+}
+
 // updatePause handles pause screen updates.
 func (a *App) updatePause(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -459,9 +536,8 @@ func (a *App) updatePause(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Resume the session - need to restart the timer tick
 		a.gameModel.SetSession(a.session)
 		a.screen = ScreenGame
-		// Restart the session timer from where it was
-		a.session.Resume()
-		return a, screens.TickCmd()
+		// Restart the session timer (and any flashed sequence) from where it was
+		return a, a.gameModel.Resume()
 	}
 
 	// Check for quit to menu (direct quit, skipping confirmation)
@@ -522,6 +598,34 @@ func (a *App) updateResults(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a.startFeatureTour()
 	}
 
+	// Check for retry mistakes
+	if _, ok := msg.(screens.RetryMistakesMsg); ok && a.session != nil {
+		a.retryModel = screens.NewRetry(a.session.Mistakes(), a.lastDifficulty, a.lastInputMethod)
+		a.retryModel.SetSize(a.width, a.height)
+		a.screen = ScreenRetry
+		return a, a.retryModel.Init()
+	}
+
+	return a, cmd
+}
+
+// updateRetry handles mistakes drill updates.
+func (a *App) updateRetry(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	a.retryModel, cmd = a.retryModel.Update(msg)
+
+	if _, ok := msg.(screens.ReturnToResultsMsg); ok {
+		a.resultsModel.SetSize(a.width, a.height)
+		a.screen = ScreenResults
+		return a, nil
+	}
+
+	if _, ok := msg.(screens.ReturnToMenuMsg); ok {
+		a.rebuildMenu()
+		a.session = nil
+		return a.returnToMenu()
+	}
+
 	return a, cmd
 }
 
@@ -570,6 +674,10 @@ func (a *App) updateSettings(msg tea.Msg) (tea.Model, tea.Cmd) {
 	a.config = a.settingsModel.Config()
 
 	if _, ok := msg.(screens.ReturnToMenuMsg); ok {
+		// A theme or keymap picked in settings replaces one that failed to load
+		a.themeError = styles.Apply(a.config.Theme)
+		a.keymapError = keys.Apply(a.config.Keymap, a.config.KeyBindings)
+		a.applyMenuWarning()
 		return a.returnToMenu()
 	}
 
@@ -606,8 +714,7 @@ func (a *App) updateQuitConfirm(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Return to game and resume timer
 			a.gameModel.SetSession(a.session)
 			a.screen = ScreenGame
-			a.session.Resume()
-			return a, screens.TickCmd()
+			return a, a.gameModel.Resume()
 		}
 		// Return to pause screen (timer already stopped)
 		a.pauseModel.SetSession(a.session)
@@ -698,7 +805,8 @@ func (a *App) completeOnboarding(modeID, difficulty string, durationMs int64, in
 		return a, a.playBrowseModel.Init()
 	}
 	a.currentMode = mode
-	a.lastDifficulty = game.ParseDifficulty(difficulty)
+	// Onboarding only offers valid names; Medium is the fallback.
+	a.lastDifficulty, _ = game.ParseDifficulty(difficulty)
 	a.lastDuration = time.Duration(durationMs) * time.Millisecond
 	a.lastInputMethod = components.ParseInputMethod(inputMethod)
 
@@ -726,7 +834,21 @@ func (a *App) startGame() (tea.Model, tea.Cmd) {
 		return a, a.playBrowseModel.Init()
 	}
 	a.session = game.NewSession(g, a.lastDifficulty, a.lastDuration)
+	if a.config != nil && a.config.QuestionTimer {
+		a.session.QuestionLimit = game.QuestionTimeLimit(a.lastDifficulty)
+	}
 	a.gameModel = screens.NewGame(a.session, a.lastInputMethod)
+	// The ghost is optional; without statistics there is simply nothing to race.
+	// Only runs with the same question timer are comparable, since it scores
+	// differently.
+	var flash history.FlashSetup
+	if _, ok := g.(*gen.FlashAnzanGen); ok {
+		// Only runs with the same sequence length and speed are comparable
+		level := gen.FlashLevelFor(a.lastDifficulty)
+		flash = history.FlashSetup{Length: level.Count, Interval: level.Interval}
+	}
+	a.ghost, _ = history.LoadGhost(a.currentMode.Name, a.lastDifficulty, a.lastDuration, flash, a.session.QuestionLimit)
+	a.gameModel.SetGhost(a.ghost)
 	a.gameModel.SetSize(a.width, a.height)
 	a.screen = ScreenGame
 	return a, a.gameModel.Init()
@@ -754,6 +876,32 @@ func (a *App) startPlayConfig(modeID string) (tea.Model, tea.Cmd) {
 	return a, a.playConfigModel.Init()
 }
 
+// startGameFromCLI starts a game for modeID without showing the config
+// screen. Settings not in overrides come from the saved config, as they
+// would on the config screen. Durations outside the allowed list are used as-is.
+func (a *App) startGameFromCLI(modeID string, overrides screens.PlayOverrides) (tea.Model, tea.Cmd) {
+	mode, ok := modes.Get(modeID)
+	if !ok || mode == nil {
+		return a.startPlayBrowse()
+	}
+
+	cfg := screens.NewPlayConfig(mode, a.config)
+	cfg.ApplyOverrides(overrides)
+	sel, ok := cfg.Selection()
+	if !ok {
+		return a.startPlayBrowse()
+	}
+	if overrides.Duration != 0 {
+		sel.Duration = overrides.Duration
+	}
+
+	a.currentMode = sel.Mode
+	a.lastDifficulty = sel.Difficulty
+	a.lastDuration = sel.Duration
+	a.lastInputMethod = sel.InputMethod
+	return a.startGame()
+}
+
 // saveLastPlayed saves the current game configuration to config.
 // Must only be called from the main Bubble Tea update loop (single-threaded).
 func (a *App) saveLastPlayed() {
@@ -795,6 +943,83 @@ func (a *App) rebuildMenu() {
 	} else if a.updateInfo != nil && a.updateInfo.UpdateAvailable {
 		a.menuModel.SetUpdateInfo(a.updateInfo.LatestVersion)
 	}
+
+	a.applyMenuProfile()
+	a.applyMenuWarning()
+}
+
+// applyMenuWarning shows in the menu why the configured theme or keymap was
+// not loaded.
+func (a *App) applyMenuWarning() {
+	var warnings []string
+	if a.themeError != nil {
+		warnings = append(warnings, "Using the dark theme · "+a.themeError.Error())
+	}
+	if a.keymapError != nil {
+		warnings = append(warnings, "Using default keys · "+a.keymapError.Error())
+	}
+	a.menuModel.SetWarning(strings.Join(warnings, "\n"))
+}
+
+// applyMenuProfile shows the profile switcher in the menu when more than one
+// profile exists.
+func (a *App) applyMenuProfile() {
+	profiles, err := storage.ListProfiles()
+	if err != nil || len(profiles) < 2 {
+		a.menuModel.SetProfile("")
+		return
+	}
+	a.menuModel.SetProfile(storage.ActiveProfile())
+}
+
+// updateProfiles handles profile switcher updates.
+func (a *App) updateProfiles(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	a.profilesModel, cmd = a.profilesModel.Update(msg)
+
+	if _, ok := msg.(screens.ReturnToMenuMsg); ok {
+		return a.returnToMenu()
+	}
+
+	if selected, ok := msg.(screens.ProfileSelectedMsg); ok {
+		return a.switchProfile(selected.Name)
+	}
+
+	return a, cmd
+}
+
+// switchProfile makes the named profile active and reloads its config.
+// Profiles that have never been played start with onboarding.
+func (a *App) switchProfile(name string) (tea.Model, tea.Cmd) {
+	if err := storage.SetActiveProfile(name); err != nil {
+		return a.returnToMenu()
+	}
+	// Selection is non-critical; ignore save errors
+	_ = storage.SaveSelectedProfile(name)
+
+	config, _ := storage.LoadConfig()
+	if config == nil {
+		config = storage.NewConfig()
+	}
+	// A broken theme or keymap falls back to the default
+	a.themeError = styles.Apply(config.Theme)
+	a.keymapError = keys.Apply(config.Keymap, config.KeyBindings)
+	styles.SetAccessible(config.Accessible)
+	_ = expr.Apply(config.Notation)
+	gen.SetFlashOptions(config.FlashLength, time.Duration(config.FlashIntervalMs)*time.Millisecond)
+	a.config = config
+	a.settingsModel = screens.NewSettings(config)
+	a.rebuildMenu()
+
+	if !config.Onboarded {
+		a.onboardingModel = screens.NewOnboarding()
+		a.onboardingModel.SetSize(a.width, a.height)
+		a.screen = ScreenOnboarding
+		return a, a.onboardingModel.Init()
+	}
+
+	a.screen = ScreenMenu
+	return a, nil
 }
 
 // View renders the current screen.
@@ -824,6 +1049,10 @@ func (a *App) View() string {
 		return a.quitConfirmModel.View()
 	case ScreenFeatureTour:
 		return a.featureTourModel.View()
+	case ScreenProfiles:
+		return a.profilesModel.View()
+	case ScreenRetry:
+		return a.retryModel.View()
 	default:
 		return ""
 	}
@@ -836,40 +1065,14 @@ func (a *App) saveSession() {
 	}
 
 	// Build the session record
-	record, err := storage.NewSessionRecord(
-		a.currentMode.Name,
-		a.lastDifficulty.String(),
-		int(a.lastDuration.Seconds()),
-	)
+	record, err := history.NewRecord(a.session, a.currentMode.Name, a.lastDuration)
 	if err != nil {
 		a.lastSaveError = err
 		return
 	}
 
-	record.QuestionsAttempted = a.session.TotalAnswered() + a.session.Skipped
-	record.QuestionsCorrect = a.session.Correct
-	record.QuestionsWrong = a.session.Incorrect
-	record.QuestionsSkipped = a.session.Skipped
-	record.Score = a.session.Score
-	record.BestStreak = a.session.BestStreak
-	record.AvgResponseTimeMs = a.session.AvgResponseTime().Milliseconds()
-
-	// Convert question history
-	for _, h := range a.session.History {
-		record.Questions = append(record.Questions, storage.QuestionRecord{
-			Question:       h.Question,
-			Operation:      h.Operation,
-			CorrectAnswer:  h.CorrectAnswer,
-			UserAnswer:     h.UserAnswer,
-			Correct:        h.Correct,
-			Skipped:        h.Skipped,
-			ResponseTimeMs: h.ResponseTime.Milliseconds(),
-			PointsEarned:   h.PointsEarned,
-		})
-	}
-
 	// Save to storage - track error but don't disrupt gameplay flow
-	a.lastSaveError = storage.AddSession(record)
+	a.lastSaveError = history.Save(record)
 
 	// Save last played settings for Quick Play
 	a.saveLastPlayed()
@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+func TestBrokenThemeIsReported(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+	defer styles.Use(styles.Dark())
+
+	config := storage.NewConfig()
+	config.Onboarded = true
+	config.TourCompleted = true
+	config.Theme = "no-such-theme"
+	if err := storage.SaveConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	app := New()
+	app.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	if view := app.View(); !strings.Contains(view, "Using the dark theme") {
+		t.Errorf("menu does not report the broken theme:\n%s", view)
+	}
+}
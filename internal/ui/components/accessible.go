@@ -0,0 +1,40 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// QuestionText returns the question as shown to the player. In accessible
+// mode operators are ASCII and unary operators are spelled out.
+func QuestionText(q game.Question) string {
+	if styles.Accessible() {
+		return q.Format(expr.Accessible)
+	}
+	return q.Display
+}
+
+// AnswerFeedback describes an answer in words, so the result does not
+// depend on color alone.
+func AnswerFeedback(h game.QuestionHistory) string {
+	switch {
+	case h.TimedOut:
+		return fmt.Sprintf("Time's up, the answer was %d", h.CorrectAnswer)
+	case h.Skipped:
+		return fmt.Sprintf("Skipped, the answer was %d", h.CorrectAnswer)
+	case h.Correct:
+		return fmt.Sprintf("Correct, %+d points", h.PointsEarned)
+	case h.PointsEarned != 0:
+		return fmt.Sprintf("Wrong, the answer was %d, %d points", h.CorrectAnswer, h.PointsEarned)
+	default:
+		return fmt.Sprintf("Wrong, the answer was %d", h.CorrectAnswer)
+	}
+}
+
+// ScoreboardText is the scoreboard as plain text for accessible mode.
+func ScoreboardText(streak int) string {
+	return fmt.Sprintf("Multiplier %.1f, streak %d", game.StreakBonus(streak), streak)
+}
@@ -0,0 +1,46 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+func TestQuestionText(t *testing.T) {
+	defer styles.SetAccessible(false)
+
+	sqrt := &expr.UnaryPrefix{Op: expr.OpSqrt, Operand: &expr.Num{Value: 49}}
+	q := game.Question{Expression: sqrt, Display: sqrt.Format(), Answer: 7}
+
+	if got := QuestionText(q); got != "√49" {
+		t.Errorf("QuestionText = %q, want √49", got)
+	}
+	styles.SetAccessible(true)
+	if got := QuestionText(q); got != "square root of 49" {
+		t.Errorf("accessible QuestionText = %q, want spelled out", got)
+	}
+	// Without a tree there is nothing to re-render
+	if got := QuestionText(game.Question{Display: "6 × 7"}); got != "6 × 7" {
+		t.Errorf("QuestionText without expression = %q", got)
+	}
+}
+
+func TestAnswerFeedback(t *testing.T) {
+	tests := []struct {
+		h    game.QuestionHistory
+		want string
+	}{
+		{game.QuestionHistory{Correct: true, CorrectAnswer: 42, PointsEarned: 150}, "Correct, +150 points"},
+		{game.QuestionHistory{CorrectAnswer: 42, PointsEarned: -25}, "Wrong, the answer was 42, -25 points"},
+		{game.QuestionHistory{CorrectAnswer: 42}, "Wrong, the answer was 42"},
+		{game.QuestionHistory{Skipped: true, CorrectAnswer: 42}, "Skipped, the answer was 42"},
+		{game.QuestionHistory{Skipped: true, TimedOut: true, CorrectAnswer: 42}, "Time's up, the answer was 42"},
+	}
+	for _, tt := range tests {
+		if got := AnswerFeedback(tt.h); got != tt.want {
+			t.Errorf("AnswerFeedback(%+v) = %q, want %q", tt.h, got, tt.want)
+		}
+	}
+}
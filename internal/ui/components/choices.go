@@ -7,6 +7,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -78,26 +79,10 @@ func (m ChoicesModel) Update(msg tea.Msg) (ChoicesModel, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "1":
-			if len(m.choices) > 0 {
-				m.selected = 0
-				return m, m.selectChoice(0)
-			}
-		case "2":
-			if len(m.choices) > 1 {
-				m.selected = 1
-				return m, m.selectChoice(1)
-			}
-		case "3":
-			if len(m.choices) > 2 {
-				m.selected = 2
-				return m, m.selectChoice(2)
-			}
-		case "4":
-			if len(m.choices) > 3 {
-				m.selected = 3
-				return m, m.selectChoice(3)
+		for i, action := range keys.Choices {
+			if i < len(m.choices) && keys.Matches(msg, action) {
+				m.selected = i
+				return m, m.selectChoice(i)
 			}
 		}
 	}
@@ -121,19 +106,19 @@ func (m ChoicesModel) View() string {
 
 	var parts []string
 	for i, choice := range m.choices {
-		keyLabel := fmt.Sprintf("[%d]", i+1)
+		keyLabel := "[" + keys.Label(keys.Choices[i]) + "]"
 		valueStr := strconv.Itoa(choice)
 
 		var style lipgloss.Style
 		if m.errorIndex == i {
 			// Wrong choice - show in red
-			style = styles.Incorrect
+			style = styles.Current().Incorrect
 		} else if m.selected == i {
-			style = styles.Selected
+			style = styles.Current().Selected
 		} else if m.focused {
-			style = styles.Normal
+			style = styles.Current().Normal
 		} else {
-			style = styles.Dim
+			style = styles.Current().Dim
 		}
 
 		part := style.Render(fmt.Sprintf("%s %s", keyLabel, valueStr))
@@ -0,0 +1,53 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// Below these sizes screens switch to their compact layout: one-line
+// status rows and abbreviated hints instead of centered blocks.
+const (
+	CompactWidth  = 50
+	CompactHeight = 16
+)
+
+// CompactHintsHeight is the height reserved for compact hints.
+const CompactHintsHeight = 1
+
+// IsCompact reports whether a terminal of the given size needs the compact
+// layout. A zero size has not been reported yet and is not compact.
+func IsCompact(width, height int) bool {
+	if width <= 0 || height <= 0 {
+		return false
+	}
+	return width < CompactWidth || height < CompactHeight
+}
+
+// RenderHintsCompact renders hints on a single centered line as the key
+// and the first word of the action ("S skip  P pause"). When they do not
+// fit the width, the gaps narrow to one space, then hints are dropped from
+// the end.
+func RenderHintsCompact(hints []Hint, width int) string {
+	parts := make([]string, 0, len(hints))
+	for _, h := range hints {
+		part := h.label()
+		if words := strings.Fields(h.Action); len(words) > 0 {
+			part += " " + strings.ToLower(words[0])
+		}
+		parts = append(parts, part)
+	}
+
+	line := strings.Join(parts, "  ")
+	if lipgloss.Width(line) > width {
+		line = strings.Join(parts, " ")
+	}
+	for len(parts) > 1 && lipgloss.Width(line) > width {
+		parts = parts[:len(parts)-1]
+		line = strings.Join(parts, " ")
+	}
+
+	return styles.Current().Dim.Render(lipgloss.PlaceHorizontal(width, lipgloss.Center, line))
+}
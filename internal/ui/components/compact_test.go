@@ -0,0 +1,52 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+)
+
+func TestIsCompact(t *testing.T) {
+	tests := []struct {
+		width, height int
+		want          bool
+	}{
+		{40, 10, true},
+		{60, 15, true},
+		{45, 24, true},
+		{80, 24, false},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := IsCompact(tt.width, tt.height); got != tt.want {
+			t.Errorf("IsCompact(%d, %d) = %v, want %v", tt.width, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestRenderHintsCompact(t *testing.T) {
+	hints := []Hint{
+		KeyHint("Back", keys.Back),
+		KeyHint("Retry mistakes", keys.Mistakes),
+		KeyHint("Play", keys.Select),
+	}
+
+	tests := []struct {
+		width int
+		want  string
+	}{
+		{40, "Esc back  R retry  Enter play"},
+		{26, "Esc back R retry"},
+		{28, "Esc back R retry Enter play"},
+	}
+	for _, tt := range tests {
+		got := RenderHintsCompact(hints, tt.width)
+		if strings.TrimSpace(got) != tt.want {
+			t.Errorf("RenderHintsCompact(width %d) = %q, want %q", tt.width, got, tt.want)
+		}
+		if strings.Contains(got, "\n") {
+			t.Errorf("RenderHintsCompact(width %d) wrapped: %q", tt.width, got)
+		}
+	}
+}
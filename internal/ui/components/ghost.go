@@ -0,0 +1,83 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+const (
+	// GhostTrackChar is the character for the track ahead of the player.
+	GhostTrackChar = "─"
+	// GhostTrackFilledChar is the character for the track behind the player.
+	GhostTrackFilledChar = "━"
+	// GhostMarker marks the ghost's position on the track.
+	GhostMarker = "◇"
+	// PlayerMarker marks the player's position on the track.
+	PlayerMarker = "●"
+
+	// ghostTrackMinWidth is the narrowest track worth drawing.
+	ghostTrackMinWidth = 8
+)
+
+// RenderGhostRace renders a one-line race against the best earlier run:
+// a track scaled to the best run's final score with markers for the player
+// and the ghost, followed by the current lead or deficit. When the width is
+// too small for a track, only the label and delta are shown.
+func RenderGhostRace(score, ghostScore, ghostFinal, width int) string {
+	label := styles.Current().Dim.Render("Best")
+	delta := RenderGhostDelta(score - ghostScore)
+
+	trackWidth := width - lipgloss.Width(label) - lipgloss.Width(delta) - 2
+	if trackWidth < ghostTrackMinWidth || ghostFinal <= 0 {
+		return label + " " + delta
+	}
+
+	you := trackPosition(score, ghostFinal, trackWidth)
+	ghost := trackPosition(ghostScore, ghostFinal, trackWidth)
+
+	var track strings.Builder
+	for i := 0; i < trackWidth; i++ {
+		switch {
+		case i == you:
+			track.WriteString(styles.Current().Accent.Render(PlayerMarker))
+		case i == ghost:
+			track.WriteString(styles.Current().Dim.Render(GhostMarker))
+		case i < you:
+			track.WriteString(styles.Current().Accent.Render(GhostTrackFilledChar))
+		default:
+			track.WriteString(styles.Current().Dim.Render(GhostTrackChar))
+		}
+	}
+
+	return label + " " + track.String() + " " + delta
+}
+
+// RenderGhostDelta renders the difference to the ghost's score, with a
+// sign even when level.
+func RenderGhostDelta(delta int) string {
+	switch {
+	case delta > 0:
+		return styles.Current().Correct.Render(fmt.Sprintf("+%d", delta))
+	case delta < 0:
+		return styles.Current().Incorrect.Render(fmt.Sprintf("%d", delta))
+	default:
+		return styles.Current().Dim.Render("±0")
+	}
+}
+
+// trackPosition maps a score onto a track cell. Scores beyond the ghost's
+// final score stay on the last cell.
+func trackPosition(score, final, width int) int {
+	if score <= 0 {
+		return 0
+	}
+	pos := score * (width - 1) / final
+	if pos > width-1 {
+		pos = width - 1
+	}
+	return pos
+}
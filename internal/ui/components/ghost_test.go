@@ -0,0 +1,28 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRenderGhostRace(t *testing.T) {
+	wide := RenderGhostRace(200, 100, 400, 40)
+	if w := lipgloss.Width(wide); w != 40 {
+		t.Errorf("width = %d, want 40: %q", w, wide)
+	}
+	for _, want := range []string{"Best", PlayerMarker, GhostMarker, "+100"} {
+		if !strings.Contains(wide, want) {
+			t.Errorf("race %q missing %q", wide, want)
+		}
+	}
+
+	narrow := RenderGhostRace(50, 100, 400, 12)
+	if strings.Contains(narrow, PlayerMarker) || !strings.Contains(narrow, "-50") {
+		t.Errorf("narrow race = %q, want only the delta", narrow)
+	}
+	if lipgloss.Width(narrow) > 12 {
+		t.Errorf("narrow race too wide: %q", narrow)
+	}
+}
@@ -71,6 +71,11 @@ func (m InputModel) Value() string {
 	return m.textInput.Value()
 }
 
+// SetValue replaces the input value, e.g. to show a recorded answer.
+func (m *InputModel) SetValue(value string) {
+	m.textInput.SetValue(value)
+}
+
 // Reset clears the input field.
 func (m *InputModel) Reset() {
 	m.textInput.Reset()
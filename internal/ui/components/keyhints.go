@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -12,8 +13,23 @@ const HintsHeight = 3
 
 // Hint represents a key-action pair for navigation hints.
 type Hint struct {
-	Key    string // The key to press (e.g., "Enter", "S", "↑↓")
-	Action string // The action description (e.g., "Continue", "Skip")
+	Key    string        // The key to press (e.g., "Enter", "S", "↑↓"); used when Keys is empty
+	Action string        // The action description (e.g., "Continue", "Skip")
+	Keys   []keys.Action // Keymap actions whose keys are shown, resolved when rendering
+}
+
+// KeyHint returns a hint showing the keys bound to the given actions
+// in the active keymap.
+func KeyHint(action string, actions ...keys.Action) Hint {
+	return Hint{Action: action, Keys: actions}
+}
+
+// label returns the key text of the hint.
+func (h Hint) label() string {
+	if len(h.Keys) > 0 {
+		return keys.Label(h.Keys...)
+	}
+	return h.Key
 }
 
 // RenderHintsResponsive renders hints with width-aware wrapping.
@@ -24,12 +40,12 @@ func RenderHintsResponsive(hints []Hint, width int) string {
 	gap := "    "
 	parts := make([]string, len(hints))
 	for i, h := range hints {
-		parts[i] = "[" + h.Key + "] " + h.Action
+		parts[i] = "[" + h.label() + "] " + h.Action
 	}
 
 	single := strings.Join(parts, gap)
 	if lipgloss.Width(single) <= width {
-		return styles.Dim.Render(single)
+		return styles.Current().Dim.Render(single)
 	}
 
 	// Find the split point that minimizes the width difference between two rows.
@@ -54,5 +70,5 @@ func RenderHintsResponsive(hints []Hint, width int) string {
 	centered1 := lipgloss.PlaceHorizontal(width, lipgloss.Center, row1)
 	centered2 := lipgloss.PlaceHorizontal(width, lipgloss.Center, row2)
 
-	return styles.Dim.Render(centered1 + "\n" + centered2)
+	return styles.Current().Dim.Render(centered1 + "\n" + centered2)
 }
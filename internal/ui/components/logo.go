@@ -29,7 +29,7 @@ func LogoSeparator() string {
 func LogoColored() string {
 	lines := strings.Split(Logo(), "\n")
 	for i, line := range lines {
-		lines[i] = styles.Logo.Render(line)
+		lines[i] = styles.Current().Logo.Render(line)
 	}
 	return strings.Join(lines, "\n")
 }
@@ -37,12 +37,12 @@ func LogoColored() string {
 // LogoColoredForWidth returns the colored logo based on terminal width.
 func LogoColoredForWidth(width int) string {
 	if width < LogoMinWidth {
-		return styles.Logo.Render(styles.Bold.Render("ArithmeGo"))
+		return styles.Current().Logo.Render(styles.Current().Bold.Render("ArithmeGo"))
 	}
 	return LogoColored()
 }
 
 // Tagline returns the game's tagline.
 func Tagline() string {
-	return styles.Tagline.Render("Your AI is thinking. You should too.")
+	return styles.Current().Tagline.Render("Your AI is thinking. You should too.")
 }
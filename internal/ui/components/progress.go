@@ -46,9 +46,9 @@ func ProgressDotsColored(current, total int) string {
 	var parts []string
 	for i := 0; i < total; i++ {
 		if i < current {
-			parts = append(parts, styles.Logo.Render("●"))
+			parts = append(parts, styles.Current().Logo.Render("●"))
 		} else {
-			parts = append(parts, styles.Dim.Render("○"))
+			parts = append(parts, styles.Current().Dim.Render("○"))
 		}
 	}
 	return strings.Join(parts, " ")
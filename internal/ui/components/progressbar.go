@@ -2,6 +2,7 @@ package components
 
 import (
 	"strings"
+	"time"
 
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
@@ -38,13 +39,27 @@ func RenderProgressBarColored(accuracy float64, width int) string {
 	bar := renderProgressBar(accuracy, width)
 
 	if accuracy >= 80 {
-		return styles.Correct.Render(bar)
+		return styles.Current().Correct.Render(bar)
 	} else if accuracy < 60 {
-		return styles.Incorrect.Render(bar)
+		return styles.Current().Incorrect.Render(bar)
 	}
 	return bar // Default color for medium accuracy
 }
 
+// RenderCountdownBar renders the question timer as a bar that shrinks as
+// time runs out. The last quarter is shown in the incorrect color.
+func RenderCountdownBar(left, limit time.Duration, width int) string {
+	if limit <= 0 {
+		return ""
+	}
+	percent := float64(left) / float64(limit) * 100
+	bar := renderProgressBar(percent, width)
+	if percent < 25 {
+		return styles.Current().Incorrect.Render(bar)
+	}
+	return bar
+}
+
 // ProgressBarWidth returns the appropriate progress bar width based on terminal width.
 func ProgressBarWidth(termWidth int) int {
 	switch {
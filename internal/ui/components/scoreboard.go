@@ -25,7 +25,7 @@ var (
 // The multiplier is styled with the Multiplier style (yellow).
 func RenderMultiplier(multiplier float64) string {
 	text := fmt.Sprintf("×%.1f", multiplier)
-	return styles.Multiplier.Render(text)
+	return styles.Current().Multiplier.Render(text)
 }
 
 // RenderStreakBar renders a progress bar showing progress toward the next tier.
@@ -42,11 +42,11 @@ func RenderStreakBar(streak int, tick int) string {
 	tier := game.GetStreakTier(streak)
 
 	if tier == game.TierNone {
-		return styles.StreakNone.Render("[" + strings.Repeat(emptyChar, streakBarWidth) + "]")
+		return styles.Current().StreakNone.Render("[" + strings.Repeat(emptyChar, streakBarWidth) + "]")
 	}
 
 	if tier == game.TierLegendary {
-		return styles.StreakLegendary.Render("<" + legendaryBar + ">")
+		return styles.Current().StreakLegendary.Render("<" + legendaryBar + ">")
 	}
 
 	// Progress within current tier: each tier spans 5 streaks, bar has 10 slots
@@ -60,18 +60,18 @@ func RenderStreakBar(streak int, tick int) string {
 	var style lipgloss.Style
 	switch tier {
 	case game.TierBuilding:
-		style = styles.StreakBuilding
+		style = styles.Current().StreakBuilding
 	case game.TierStreak:
-		style = styles.StreakActive
+		style = styles.Current().StreakActive
 	case game.TierMax:
-		style = styles.StreakMax
+		style = styles.Current().StreakMax
 	case game.TierBlazing:
-		style = styles.StreakBlazing
+		style = styles.Current().StreakBlazing
 	case game.TierUnstoppable:
-		style = styles.StreakUnstoppable
+		style = styles.Current().StreakUnstoppable
 		open, close = "«", "»"
 	default:
-		style = styles.StreakNone
+		style = styles.Current().StreakNone
 	}
 
 	return style.Render(open + bar + close)
@@ -81,14 +81,14 @@ func RenderStreakBar(streak int, tick int) string {
 // RenderScore renders the score with comma formatting (e.g., "1,234").
 func RenderScore(score int) string {
 	text := formatNumber(score)
-	return styles.Score.Render(text)
+	return styles.Current().Score.Render(text)
 }
 
 // RenderScoreLarge renders the score in a prominent style for the game screen.
 // Uses bright white color and comma formatting.
 func RenderScoreLarge(score int) string {
 	text := formatNumber(score)
-	return styles.ScoreLarge.Render(text)
+	return styles.Current().ScoreLarge.Render(text)
 }
 
 // RenderScoreDelta renders the points gained or lost from the last answer.
@@ -100,10 +100,10 @@ func RenderScoreDelta(delta int) string {
 
 	if delta > 0 {
 		text = fmt.Sprintf("+%d", delta)
-		style = styles.Correct.Bold(true)
+		style = styles.Current().Correct.Bold(true)
 	} else if delta < 0 {
 		text = fmt.Sprintf("%d", delta)
-		style = styles.Incorrect.Bold(true)
+		style = styles.Current().Incorrect.Bold(true)
 	} else {
 		return ""
 	}
@@ -118,7 +118,7 @@ func RenderScoreboard(streak int, tick int) string {
 
 	mult := RenderMultiplier(multiplier)
 	if streak > 0 {
-		mult += styles.Dim.Render(" · ") + styles.Multiplier.Render(fmt.Sprintf("Streak %d", streak))
+		mult += styles.Current().Dim.Render(" · ") + styles.Current().Multiplier.Render(fmt.Sprintf("Streak %d", streak))
 	}
 	bar := RenderStreakBar(streak, tick)
 
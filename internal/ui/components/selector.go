@@ -21,7 +21,7 @@ type SelectorOptions struct {
 // When only Prefix is provided: "  ◀ Value ▶"
 func RenderSelector(index int, options []string, opts SelectorOptions) string {
 	if len(options) == 0 {
-		return styles.Dim.Render("[no options]")
+		return styles.Current().Dim.Render("[no options]")
 	}
 	if index < 0 || index >= len(options) {
 		// Clamp index to valid range for graceful degradation
@@ -42,14 +42,14 @@ func RenderSelector(index int, options []string, opts SelectorOptions) string {
 	renderArrow := func(arrow string, active bool, focused bool) string {
 		if focused {
 			if active {
-				return styles.Accent.Render(arrow)
+				return styles.Current().Accent.Render(arrow)
 			}
-			return styles.Dim.Render(arrow)
+			return styles.Current().Dim.Render(arrow)
 		}
 		if active {
-			return styles.Subtle.Render(arrow)
+			return styles.Current().Subtle.Render(arrow)
 		}
-		return styles.Dim.Render(arrow)
+		return styles.Current().Dim.Render(arrow)
 	}
 
 	if opts.Label != "" {
@@ -72,17 +72,17 @@ func RenderSelector(index int, options []string, opts SelectorOptions) string {
 		// Format: Label  ◀ Value ▶[padding] (arrows hug the value)
 		if opts.Focused {
 			return fmt.Sprintf("%s  %s %s %s%s",
-				styles.Bold.Render(label),
+				styles.Current().Bold.Render(label),
 				renderArrow("◀", leftActive, true),
-				styles.Selected.Render(value),
+				styles.Current().Selected.Render(value),
 				renderArrow("▶", rightActive, true),
 				padding,
 			)
 		}
 		return fmt.Sprintf("%s  %s %s %s%s",
-			styles.Subtle.Render(label),
+			styles.Current().Subtle.Render(label),
 			renderArrow("◀", leftActive, false),
-			styles.Unselected.Render(value),
+			styles.Current().Unselected.Render(value),
 			renderArrow("▶", rightActive, false),
 			padding,
 		)
@@ -93,14 +93,14 @@ func RenderSelector(index int, options []string, opts SelectorOptions) string {
 		return fmt.Sprintf("%s%s %s %s",
 			opts.Prefix,
 			renderArrow("◀", leftActive, true),
-			styles.Selected.Render(value),
+			styles.Current().Selected.Render(value),
 			renderArrow("▶", rightActive, true),
 		)
 	}
 	return fmt.Sprintf("%s%s %s %s",
 		opts.Prefix,
 		renderArrow("◀", leftActive, false),
-		styles.Unselected.Render(value),
+		styles.Current().Unselected.Render(value),
 		renderArrow("▶", rightActive, false),
 	)
 }
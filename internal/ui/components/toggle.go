@@ -25,30 +25,30 @@ func RenderToggle(value bool, opts ToggleOptions) string {
 	if value {
 		// On is active
 		if opts.Focused {
-			onText = styles.Selected.Render("On")
+			onText = styles.Current().Selected.Render("On")
 		} else {
-			onText = styles.Unselected.Render("On")
+			onText = styles.Current().Unselected.Render("On")
 		}
-		offText = styles.Dim.Render("Off")
+		offText = styles.Current().Dim.Render("Off")
 	} else {
 		// Off is active
-		onText = styles.Dim.Render("On")
+		onText = styles.Current().Dim.Render("On")
 		if opts.Focused {
-			offText = styles.Selected.Render("Off")
+			offText = styles.Current().Selected.Render("Off")
 		} else {
-			offText = styles.Unselected.Render("Off")
+			offText = styles.Current().Unselected.Render("Off")
 		}
 	}
-	separator = styles.Dim.Render(" · ")
+	separator = styles.Current().Dim.Render(" · ")
 
 	if opts.Focused {
 		return fmt.Sprintf("%s  %s%s%s",
-			styles.Bold.Render(label),
+			styles.Current().Bold.Render(label),
 			onText, separator, offText,
 		)
 	}
 	return fmt.Sprintf("%s  %s%s%s",
-		styles.Subtle.Render(label),
+		styles.Current().Subtle.Render(label),
 		onText, separator, offText,
 	)
 }
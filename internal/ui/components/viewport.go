@@ -1,8 +1,13 @@
 package components
 
-import "github.com/charmbracelet/bubbles/viewport"
+import (
+	"github.com/charmbracelet/bubbles/viewport"
 
-// SetViewportSize initializes or resizes a viewport.
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+)
+
+// SetViewportSize initializes or resizes a viewport. It also applies the
+// active keymap's scroll keys, so a changed keymap takes effect on resize.
 func SetViewportSize(vp *viewport.Model, ready *bool, width, height int) {
 	if !*ready {
 		*vp = viewport.New(width, height)
@@ -12,4 +17,5 @@ func SetViewportSize(vp *viewport.Model, ready *bool, width, height int) {
 		vp.Width = width
 		vp.Height = height
 	}
+	vp.KeyMap = keys.Current().Viewport()
 }
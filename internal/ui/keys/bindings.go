@@ -0,0 +1,216 @@
+package keys
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseBindings parses key binding overrides of the form
+//
+//	skip=tab,space; pause=ctrl+p
+//
+// Each entry replaces all keys of one action. Keys are separated by commas
+// and "space" is the space bar, so ",", ";" and "=" cannot be bound.
+func ParseBindings(s string) (map[Action][]string, error) {
+	overrides := make(map[Action][]string)
+	for entry := range strings.SplitSeq(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, list, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid binding %q (use action=key,key)", entry)
+		}
+		action := Action(strings.ToLower(strings.TrimSpace(name)))
+		if !slices.Contains(Actions(), action) {
+			return nil, fmt.Errorf("unknown action %q (valid: %s)", action, actionNames())
+		}
+		if _, dup := overrides[action]; dup {
+			return nil, fmt.Errorf("action %q is bound twice", action)
+		}
+
+		var keys []string
+		for k := range strings.SplitSeq(list, ",") {
+			k, err := parseKey(k)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", action, err)
+			}
+			if !slices.Contains(keys, k) {
+				keys = append(keys, k)
+			}
+		}
+		overrides[action] = keys
+	}
+	return overrides, nil
+}
+
+// FormatBindings is the inverse of [ParseBindings], with actions sorted.
+func FormatBindings(overrides map[Action][]string) string {
+	var entries []string
+	for _, a := range Actions() {
+		keys, ok := overrides[a]
+		if !ok {
+			continue
+		}
+		names := make([]string, len(keys))
+		for i, k := range keys {
+			names[i] = k
+			if k == " " {
+				names[i] = "space"
+			}
+		}
+		entries = append(entries, string(a)+"="+strings.Join(names, ","))
+	}
+	return strings.Join(entries, "; ")
+}
+
+// namedKeys are the multi-character key names accepted in bindings,
+// besides ctrl+ and alt+ combinations and function keys.
+var namedKeys = []string{
+	"enter", "esc", "tab", "shift+tab", "backspace", "delete", "insert",
+	"up", "down", "left", "right", "home", "end", "pgup", "pgdown",
+}
+
+// parseKey validates one key name and returns it as Bubble Tea reports it.
+func parseKey(s string) (string, error) {
+	k := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case k == "":
+		return "", errors.New("empty key")
+	case k == "space":
+		return " ", nil
+	case utf8.RuneCountInString(k) == 1:
+		return k, nil
+	case slices.Contains(namedKeys, k):
+		return k, nil
+	}
+	if n, ok := strings.CutPrefix(k, "f"); ok && slices.Contains(functionKeys(), n) {
+		return k, nil
+	}
+	for _, mod := range []string{"ctrl+", "alt+"} {
+		if rest, ok := strings.CutPrefix(k, mod); ok {
+			if _, err := parseKey(rest); err == nil && rest != "space" {
+				return k, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unknown key %q", s)
+}
+
+func functionKeys() []string {
+	var n []string
+	for i := 1; i <= 12; i++ {
+		n = append(n, fmt.Sprint(i))
+	}
+	return n
+}
+
+// Load builds the named preset with the overrides applied and checks it
+// for conflicts. An empty preset is the default keymap.
+func Load(preset, overrides string) (*Keymap, error) {
+	k, err := lookupPreset(preset)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := ParseBindings(overrides)
+	if err != nil {
+		return nil, err
+	}
+	for a, keys := range parsed {
+		k.bindings[a] = keys
+	}
+	if err := k.Check(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Apply makes the configured keymap active. If it cannot be loaded, the
+// default keymap is used and the error is returned.
+func Apply(preset, overrides string) error {
+	k, err := Load(preset, overrides)
+	if err != nil {
+		Use(Default())
+		return err
+	}
+	Use(k)
+	return nil
+}
+
+// context is a set of actions that are active on the same screen. Keys
+// must be unique within a context. Contexts with typed answers reserve the
+// keys the answer input needs.
+type context struct {
+	name     string
+	actions  []Action
+	reserved []string
+}
+
+// typingKeys are the keys of the answer input.
+var typingKeys = []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "-", "backspace"}
+
+// contexts lists the screens and their actions.
+var contexts = []context{
+	{"menu", []Action{Up, Down, Select, Right, Quit}, nil},
+	{"mode browser", []Action{Back, Up, Down, Select, Right}, nil},
+	{"mode setup", []Action{Back, Up, Down, Left, Right, Select}, nil},
+	{"settings", []Action{Up, Down, Left, Right, Select, Toggle, Back}, nil},
+	{"profiles", []Action{Up, Down, Select, Right, Back, Left}, nil},
+	{"quit confirmation", []Action{Yes, No, Back, Up, Down, Left, Right, Toggle, Select}, nil},
+	{"game", []Action{Submit, Skip, Pause, Quit}, typingKeys},
+	{"game (multiple choice)", []Action{Skip, Pause, Quit, Choice1, Choice2, Choice3, Choice4}, nil},
+	{"pause", []Action{Select, Pause, Quit}, nil},
+	{"results", []Action{Up, Down, PageUp, PageDown, Select, Right, Menu, Back, Mistakes}, nil},
+	{"retry mistakes", []Action{Back, Skip, Submit}, typingKeys},
+	{"retry mistakes (multiple choice)", []Action{Back, Skip, Choice1, Choice2, Choice3, Choice4}, nil},
+	{"practice", []Action{Quit, Category, Operation, Difficulty, Harder, Easier, InputMethod, Skip, Submit}, typingKeys},
+	{"practice (multiple choice)", []Action{Quit, Category, Operation, Difficulty, Harder, Easier, InputMethod, Skip, Choice1, Choice2, Choice3, Choice4}, nil},
+	{"onboarding and feature tour", []Action{Up, Down, PageUp, PageDown, Select, Right, SkipTour, Previous, Left}, nil},
+	{"statistics", []Action{Back, ShowOperations, ShowHistory, ShowTrends, Up, Down, PageUp, PageDown}, nil},
+	{"statistics operations", []Action{Back, Category, Difficulty, Period, Up, Down, Select}, nil},
+	{"operation detail", []Action{Back, Left, Right, Mistakes, Up, Down, PageUp, PageDown}, nil},
+	{"session history", []Action{Back, Category, Difficulty, Period, Up, Down, Left, Right, Select}, nil},
+	{"session detail", []Action{Back, ShowHistory, ShowLog, Replay, Up, Down, PageUp, PageDown}, nil},
+	{"session log", []Action{Back, ShowSummary, Replay, Left, Right, Up, Down, PageUp, PageDown}, nil},
+	{"session replay", []Action{Back, Toggle, Replay, Speed1, Speed2, Speed4}, nil},
+	{"trends", []Action{Back, Metric, Period, Up, Down, PageUp, PageDown}, nil},
+}
+
+// Check returns an error describing every key that is bound to two
+// actions on the same screen, or that a typed answer needs.
+func (k *Keymap) Check() error {
+	var conflicts []string
+	for _, c := range contexts {
+		owner := make(map[string]Action)
+		for _, a := range c.actions {
+			for _, key := range k.bindings[a] {
+				label := KeyLabel(key)
+				if slices.Contains(c.reserved, key) {
+					conflicts = append(conflicts, fmt.Sprintf("%s: %s is needed to type answers but bound to %s", c.name, label, a))
+					continue
+				}
+				if prev, ok := owner[key]; ok && prev != a {
+					conflicts = append(conflicts, fmt.Sprintf("%s: %s is bound to both %s and %s", c.name, label, prev, a))
+					continue
+				}
+				owner[key] = a
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("key conflicts: %s", strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+func actionNames() string {
+	names := make([]string, 0, len(Actions()))
+	for _, a := range Actions() {
+		names = append(names, string(a))
+	}
+	return strings.Join(names, ", ")
+}
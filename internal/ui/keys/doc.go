@@ -0,0 +1,17 @@
+// Package keys maps keys to the actions of the TUI screens.
+//
+// Screens match keys through the active [Keymap] instead of comparing key
+// strings, and build their hints from it, so a remapped key changes both:
+//
+//	case keys.Matches(msg, keys.Skip):
+//	    m.skip()
+//	...
+//	components.KeyHint("Skip", keys.Skip) // "[S] Skip" or "[+] Skip"
+//
+// The keymap comes from the keymap setting (a preset: default, vim or
+// numpad) with the key_bindings setting applied on top:
+//
+//	err := keys.Apply(cfg.Keymap, cfg.KeyBindings)
+//
+// [Load] rejects keymaps where a key does two things on one screen.
+package keys
@@ -0,0 +1,216 @@
+package keys
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Action is a command that can be bound to keys. Its value is the name
+// used in the key_bindings setting.
+type Action string
+
+// Navigation, shared by the menu-like screens.
+const (
+	Up       Action = "up"
+	Down     Action = "down"
+	Left     Action = "left"
+	Right    Action = "right"
+	Select   Action = "select"
+	Back     Action = "back"
+	Toggle   Action = "toggle"
+	PageUp   Action = "page_up"
+	PageDown Action = "page_down"
+)
+
+// Game and practice.
+const (
+	Submit      Action = "submit"
+	Skip        Action = "skip"
+	Pause       Action = "pause"
+	Quit        Action = "quit"
+	Choice1     Action = "choice_1"
+	Choice2     Action = "choice_2"
+	Choice3     Action = "choice_3"
+	Choice4     Action = "choice_4"
+	Category    Action = "category"
+	Operation   Action = "operation"
+	Difficulty  Action = "difficulty"
+	Harder      Action = "harder"
+	Easier      Action = "easier"
+	InputMethod Action = "input_method"
+)
+
+// Results, dialogs and the feature tour.
+const (
+	Menu     Action = "menu"
+	Mistakes Action = "mistakes"
+	Yes      Action = "yes"
+	No       Action = "no"
+	SkipTour Action = "skip_tour"
+	Previous Action = "previous"
+)
+
+// Statistics.
+const (
+	ShowOperations Action = "show_operations"
+	ShowHistory    Action = "show_history"
+	ShowTrends     Action = "show_trends"
+	ShowLog        Action = "show_log"
+	ShowSummary    Action = "show_summary"
+	Period         Action = "period"
+	Metric         Action = "metric"
+	Replay         Action = "replay"
+	Speed1         Action = "speed_1"
+	Speed2         Action = "speed_2"
+	Speed4         Action = "speed_4"
+)
+
+// Choices lists the multiple choice actions in answer order.
+var Choices = []Action{Choice1, Choice2, Choice3, Choice4}
+
+// Keymap binds actions to keys. Keys are written as Bubble Tea reports
+// them ("enter", "ctrl+d", "a"); " " is the space bar. Letters match
+// either case.
+type Keymap struct {
+	Name     string
+	bindings map[Action][]string
+}
+
+// Keys returns the keys bound to an action, primary key first.
+func (k *Keymap) Keys(a Action) []string {
+	return k.bindings[a]
+}
+
+// Matches reports whether msg is a key bound to any of the actions.
+func (k *Keymap) Matches(msg tea.KeyMsg, actions ...Action) bool {
+	pressed := normalizeKey(msg.String())
+	for _, a := range actions {
+		if slices.Contains(k.bindings[a], pressed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns the hint text for the actions: the primary key of each,
+// e.g. "↑↓", "Esc" or "1-4".
+func (k *Keymap) Label(actions ...Action) string {
+	parts := make([]string, 0, len(actions))
+	for _, a := range actions {
+		if ks := k.bindings[a]; len(ks) > 0 {
+			parts = append(parts, KeyLabel(ks[0]))
+		}
+	}
+
+	switch {
+	case len(parts) == 2 && isArrow(parts[0]) && isArrow(parts[1]):
+		return parts[0] + parts[1]
+	case len(parts) > 2 && isDigitRun(parts):
+		return parts[0] + "-" + parts[len(parts)-1]
+	}
+	return strings.Join(parts, "/")
+}
+
+// Viewport returns viewport key bindings for scrolling with the keymap's
+// up, down, page up and page down keys.
+func (k *Keymap) Viewport() viewport.KeyMap {
+	disabled := key.NewBinding(key.WithDisabled())
+	return viewport.KeyMap{
+		Up:           key.NewBinding(key.WithKeys(k.Keys(Up)...)),
+		Down:         key.NewBinding(key.WithKeys(k.Keys(Down)...)),
+		PageUp:       key.NewBinding(key.WithKeys(k.Keys(PageUp)...)),
+		PageDown:     key.NewBinding(key.WithKeys(k.Keys(PageDown)...)),
+		HalfPageUp:   disabled,
+		HalfPageDown: disabled,
+		Left:         disabled,
+		Right:        disabled,
+	}
+}
+
+// KeyLabel returns how a key is shown in hints.
+func KeyLabel(k string) string {
+	switch k {
+	case " ":
+		return "Space"
+	case "enter":
+		return "Enter"
+	case "esc":
+		return "Esc"
+	case "tab":
+		return "Tab"
+	case "backspace":
+		return "Bksp"
+	case "up":
+		return "↑"
+	case "down":
+		return "↓"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case "pgup":
+		return "PgUp"
+	case "pgdown":
+		return "PgDn"
+	}
+	if mod, rest, ok := strings.Cut(k, "+"); ok && rest != "" {
+		return strings.ToUpper(mod[:1]) + mod[1:] + "+" + KeyLabel(rest)
+	}
+	return strings.ToUpper(k)
+}
+
+// current is the active keymap.
+var current = Default()
+
+// Current returns the active keymap.
+func Current() *Keymap {
+	return current
+}
+
+// Use makes k the active keymap.
+func Use(k *Keymap) {
+	if k != nil {
+		current = k
+	}
+}
+
+// Matches reports whether msg is bound to any of the actions in the
+// active keymap.
+func Matches(msg tea.KeyMsg, actions ...Action) bool {
+	return current.Matches(msg, actions...)
+}
+
+// Label returns the hint text for the actions in the active keymap.
+func Label(actions ...Action) string {
+	return current.Label(actions...)
+}
+
+// normalizeKey lowercases single letters so that bindings match either case.
+func normalizeKey(k string) string {
+	if utf8.RuneCountInString(k) == 1 {
+		return strings.ToLower(k)
+	}
+	return k
+}
+
+func isArrow(label string) bool {
+	return label == "↑" || label == "↓" || label == "←" || label == "→"
+}
+
+// isDigitRun reports whether labels are consecutive digits, like 1 2 3 4.
+func isDigitRun(labels []string) bool {
+	for i, l := range labels {
+		if len(l) != 1 || l[0] < '0' || l[0] > '9' {
+			return false
+		}
+		if i > 0 && l[0] != labels[i-1][0]+1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,162 @@
+package keys
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func keyMsg(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "ctrl+d":
+		return tea.KeyMsg{Type: tea.KeyCtrlD}
+	case " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestPresetsHaveNoConflicts(t *testing.T) {
+	for _, name := range PresetNames() {
+		k, err := Load(name, "")
+		if err != nil {
+			t.Errorf("Load(%q) error = %v", name, err)
+			continue
+		}
+		for _, a := range Actions() {
+			if len(k.Keys(a)) == 0 {
+				t.Errorf("%s: action %s has no keys", name, a)
+			}
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	k := Default()
+	tests := []struct {
+		key    string
+		action Action
+		want   bool
+	}{
+		{"s", Skip, true},
+		{"S", Skip, true}, // letters match either case
+		{" ", Skip, true},
+		{"p", Skip, false},
+		{"enter", Submit, true},
+		{"up", Up, true},
+		{"k", Up, true},
+		{"esc", Quit, true},
+	}
+	for _, tt := range tests {
+		if got := k.Matches(keyMsg(tt.key), tt.action); got != tt.want {
+			t.Errorf("Matches(%q, %s) = %v, want %v", tt.key, tt.action, got, tt.want)
+		}
+	}
+
+	if !Vim().Matches(keyMsg("ctrl+d"), PageDown) {
+		t.Error("vim keymap should page down with ctrl+d")
+	}
+	if !Numpad().Matches(keyMsg("+"), Skip) || Default().Matches(keyMsg("+"), Skip) {
+		t.Error("only the numpad keymap should skip with +")
+	}
+}
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		keymap  *Keymap
+		actions []Action
+		want    string
+	}{
+		{Default(), []Action{Up, Down}, "↑↓"},
+		{Default(), []Action{Left, Right}, "←→"},
+		{Default(), []Action{Back}, "Esc"},
+		{Default(), []Action{Toggle}, "Space"},
+		{Default(), Choices, "1-4"},
+		{Default(), []Action{Speed1, Speed2, Speed4}, "1/2/4"},
+		{Default(), []Action{Yes, No}, "Y/N"},
+		{Default(), []Action{PageUp, PageDown}, "PgUp/PgDn"},
+		{Vim(), []Action{Up, Down}, "K/J"},
+		{Numpad(), []Action{Up, Down}, "8/2"},
+		{Numpad(), []Action{Skip}, "+"},
+	}
+	for _, tt := range tests {
+		if got := tt.keymap.Label(tt.actions...); got != tt.want {
+			t.Errorf("%s.Label(%v) = %q, want %q", tt.keymap.Name, tt.actions, got, tt.want)
+		}
+	}
+
+	if got := KeyLabel("ctrl+p"); got != "Ctrl+P" {
+		t.Errorf("KeyLabel(ctrl+p) = %q, want Ctrl+P", got)
+	}
+}
+
+func TestParseBindings(t *testing.T) {
+	got, err := ParseBindings(" Skip = Tab, space ; pause=ctrl+p;")
+	if err != nil {
+		t.Fatalf("ParseBindings error = %v", err)
+	}
+	if s := FormatBindings(got); s != "pause=ctrl+p; skip=tab,space" {
+		t.Errorf("FormatBindings = %q", s)
+	}
+
+	bad := []string{
+		"skip",            // no keys
+		"skip=",           // empty key
+		"jump=x",          // unknown action
+		"skip=entr",       // unknown key
+		"skip=s; skip=x",  // bound twice
+		"skip=ctrl+space", // unsupported combination
+	}
+	for _, s := range bad {
+		if _, err := ParseBindings(s); err == nil {
+			t.Errorf("ParseBindings(%q) should fail", s)
+		}
+	}
+}
+
+func TestLoadDetectsConflicts(t *testing.T) {
+	tests := []struct {
+		preset, bindings, want string
+	}{
+		{"", "pause=s", "game: S is bound to both skip and pause"},
+		{"", "submit=5", "needed to type answers"},
+		{"numpad", "menu=8", "results: 8 is bound to both up and menu"},
+		{"emacs", "", "unknown keymap"},
+	}
+	for _, tt := range tests {
+		_, err := Load(tt.preset, tt.bindings)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Load(%q, %q) error = %v, want %q", tt.preset, tt.bindings, err, tt.want)
+		}
+	}
+
+	// Rebinding to keys that are free on every screen is fine
+	k, err := Load("vim", "skip=tab; pause=ctrl+p")
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+	if !k.Matches(tea.KeyMsg{Type: tea.KeyTab}, Skip) || k.Matches(keyMsg("s"), Skip) {
+		t.Error("skip should be rebound from s to tab")
+	}
+}
+
+func TestApplyFallsBackToDefault(t *testing.T) {
+	defer Use(Default())
+
+	if err := Apply("numpad", ""); err != nil || Current().Name != PresetNumpad {
+		t.Fatalf("Apply(numpad) = %v, keymap %q", err, Current().Name)
+	}
+	if err := Apply("", "pause=s"); err == nil {
+		t.Error("Apply with a conflict should fail")
+	}
+	if Current().Name != PresetDefault || !Matches(keyMsg("p"), Pause) {
+		t.Errorf("after a failed Apply, keymap = %q, want the default", Current().Name)
+	}
+}
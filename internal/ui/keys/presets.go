@@ -0,0 +1,142 @@
+package keys
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Preset names.
+const (
+	PresetDefault = "default"
+	PresetVim     = "vim"
+	PresetNumpad  = "numpad"
+)
+
+// presets lists the built-in keymaps in picker order.
+var presets = []struct {
+	name  string
+	build func() *Keymap
+}{
+	{PresetDefault, Default},
+	{PresetVim, Vim},
+	{PresetNumpad, Numpad},
+}
+
+// PresetNames returns the names of the built-in keymaps.
+func PresetNames() []string {
+	names := make([]string, len(presets))
+	for i, p := range presets {
+		names[i] = p.name
+	}
+	return names
+}
+
+// Default is the standard keymap: arrow keys with h/j/k/l as alternatives,
+// and letters for screen commands.
+func Default() *Keymap {
+	return &Keymap{Name: PresetDefault, bindings: defaultBindings()}
+}
+
+func defaultBindings() map[Action][]string {
+	return map[Action][]string{
+		Up:       {"up", "k"},
+		Down:     {"down", "j"},
+		Left:     {"left", "h"},
+		Right:    {"right", "l"},
+		Select:   {"enter"},
+		Back:     {"esc"},
+		Toggle:   {" "},
+		PageUp:   {"pgup"},
+		PageDown: {"pgdown"},
+
+		Submit:      {"enter"},
+		Skip:        {"s", " "},
+		Pause:       {"p"},
+		Quit:        {"esc", "q"},
+		Choice1:     {"1"},
+		Choice2:     {"2"},
+		Choice3:     {"3"},
+		Choice4:     {"4"},
+		Category:    {"c"},
+		Operation:   {"o"},
+		Difficulty:  {"d"},
+		Harder:      {"up", "k"},
+		Easier:      {"down", "j"},
+		InputMethod: {"m"},
+
+		Menu:     {"m"},
+		Mistakes: {"r"},
+		Yes:      {"y"},
+		No:       {"n"},
+		SkipTour: {"s"},
+		Previous: {"b"},
+
+		ShowOperations: {"o"},
+		ShowHistory:    {"h"},
+		ShowTrends:     {"t"},
+		ShowLog:        {"l"},
+		ShowSummary:    {"s"},
+		Period:         {"p"},
+		Metric:         {"m"},
+		Replay:         {"r"},
+		Speed1:         {"1"},
+		Speed2:         {"2"},
+		Speed4:         {"4"},
+	}
+}
+
+// Vim shows h/j/k/l in hints and scrolls with the Ctrl paging keys.
+func Vim() *Keymap {
+	b := defaultBindings()
+	b[Up] = []string{"k", "up"}
+	b[Down] = []string{"j", "down"}
+	b[Left] = []string{"h", "left"}
+	b[Right] = []string{"l", "right"}
+	b[Harder] = []string{"k", "up"}
+	b[Easier] = []string{"j", "down"}
+	b[PageUp] = []string{"ctrl+u", "ctrl+b", "pgup"}
+	b[PageDown] = []string{"ctrl+d", "ctrl+f", "pgdown"}
+	return &Keymap{Name: PresetVim, bindings: b}
+}
+
+// Numpad plays and navigates from the numeric keypad: 8/2/4/6 move,
+// + skips, * pauses, / goes back or quits and . cycles the practice
+// difficulty. Digits stay answer input wherever an answer is typed.
+// The letter keys keep working.
+func Numpad() *Keymap {
+	b := defaultBindings()
+	b[Up] = []string{"8", "up"}
+	b[Down] = []string{"2", "down"}
+	b[Left] = []string{"4", "left"}
+	b[Right] = []string{"6", "right"}
+	b[Toggle] = []string{"5", " "}
+	b[Back] = []string{"/", "esc"}
+	b[Skip] = []string{"+", "s", " "}
+	b[Pause] = []string{"*", "p"}
+	b[Quit] = []string{"/", "esc", "q"}
+	b[Difficulty] = []string{".", "d"}
+	b[Mistakes] = []string{"-", "r"}
+	b[SkipTour] = []string{"+", "s"}
+	return &Keymap{Name: PresetNumpad, bindings: b}
+}
+
+// Actions returns every action, sorted by name.
+func Actions() []Action {
+	return slices.Sorted(maps.Keys(defaultBindings()))
+}
+
+// lookupPreset returns the built-in keymap with the given name.
+// An empty name selects the default keymap.
+func lookupPreset(name string) (*Keymap, error) {
+	if name == "" {
+		name = PresetDefault
+	}
+	for _, p := range presets {
+		if p.name == name {
+			return p.build(), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown keymap %q (valid: %s)", name, strings.Join(PresetNames(), ", "))
+}
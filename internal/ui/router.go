@@ -16,6 +16,8 @@ const (
 	ScreenOnboarding   // Phase 9
 	ScreenQuitConfirm  // Phase 11
 	ScreenFeatureTour  // Post-onboarding feature introduction
+	ScreenProfiles     // Profile switcher
+	ScreenRetry        // Untimed drill of a session's mistakes
 )
 
 // StartMode determines how the app should start (used by CLI commands).
@@ -29,6 +31,9 @@ const (
 	// StartModePlayConfig opens the play config screen with a specific mode.
 	// Requires CLIModeID to be set.
 	StartModePlayConfig
+	// StartModeGame starts a game with a specific mode, skipping the
+	// config screen. Requires CLIModeID to be set.
+	StartModeGame
 	// StartModeStatistics opens the statistics screen directly.
 	StartModeStatistics
 	// StartModeSettings opens the settings screen directly.
@@ -0,0 +1,117 @@
+package screens
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+)
+
+var update = flag.Bool("update", false, "rewrite snapshot files in testdata")
+
+// snapshotSizes are the terminal sizes views are snapshotted at: a small
+// tmux split, the compact threshold's neighbourhood, and a standard terminal.
+var snapshotSizes = [][2]int{{40, 10}, {60, 15}, {80, 24}}
+
+// assertSnapshot compares a rendered view with testdata/<name>.golden,
+// rewriting the file when run with -update.
+func assertSnapshot(t *testing.T, name, view string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(view), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading snapshot (run with -update to create it): %v", err)
+	}
+	if view != string(want) {
+		t.Errorf("view does not match %s:\n%s\nwant:\n%s", path, view, want)
+	}
+}
+
+// assertFits checks that a view is no larger than the terminal.
+func assertFits(t *testing.T, view string, width, height int) {
+	t.Helper()
+	lines := strings.Split(view, "\n")
+	if len(lines) > height {
+		t.Errorf("view is %d lines, terminal has %d", len(lines), height)
+	}
+	for _, line := range lines {
+		if w := len([]rune(line)); w > width {
+			t.Errorf("line is %d columns, terminal has %d: %q", w, width, line)
+		}
+	}
+}
+
+func TestGameSnapshots(t *testing.T) {
+	for _, size := range snapshotSizes {
+		s := game.NewSession(&listGenerator{}, game.Easy, time.Minute)
+		m := NewGame(s, components.InputTyping)
+		m.Init()
+		s.Current = &game.Question{Key: "12+30", Answer: 42, Display: "12 + 30"}
+		m.SetSize(size[0], size[1])
+
+		view := m.View()
+		assertFits(t, view, size[0], size[1])
+		assertSnapshot(t, fmt.Sprintf("game_%dx%d", size[0], size[1]), view)
+	}
+}
+
+// snapshotSession returns a finished session with fixed answers and times.
+func snapshotSession() *game.Session {
+	s := game.NewSession(&listGenerator{}, game.Easy, time.Minute)
+	answers := []struct {
+		question      string
+		answer, given int
+		points        int
+	}{
+		{"12 + 30", 42, 42, 150},
+		{"7 + 8", 15, 15, 165},
+		{"25 + 19", 44, 54, -25},
+		{"61 + 14", 75, 75, 150},
+		{"9 + 9", 18, 18, 165},
+	}
+	for _, a := range answers {
+		correct := a.answer == a.given
+		s.History = append(s.History, game.QuestionHistory{
+			Question:      a.question,
+			CorrectAnswer: a.answer,
+			UserAnswer:    a.given,
+			Correct:       correct,
+			ResponseTime:  1500 * time.Millisecond,
+			PointsEarned:  a.points,
+		})
+		s.Score += a.points
+		if correct {
+			s.Correct++
+		} else {
+			s.Incorrect++
+		}
+	}
+	s.BestStreak = 2
+	return s
+}
+
+func TestResultsSnapshots(t *testing.T) {
+	for _, size := range snapshotSizes {
+		m := NewResults(snapshotSession(), nil)
+		m.SetSize(size[0], size[1])
+
+		view := m.View()
+		assertFits(t, view, size[0], size[1])
+		assertSnapshot(t, fmt.Sprintf("results_%dx%d", size[0], size[1]), view)
+	}
+}
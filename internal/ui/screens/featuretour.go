@@ -6,6 +6,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -58,17 +59,17 @@ func (m FeatureTourModel) Update(msg tea.Msg) (FeatureTourModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
+		switch {
+		case msg.String() == "ctrl+c":
 			return m, tea.Quit
-		case "enter", "right", "l":
+		case keys.Matches(msg, keys.Select, keys.Right):
 			return m.advance()
-		case "s", "S":
+		case keys.Matches(msg, keys.SkipTour):
 			// Skip not available on statistics or finale
 			if m.step != StepStatistics && m.step != StepFinale {
 				return m.skip()
 			}
-		case "b", "B", "left", "h":
+		case keys.Matches(msg, keys.Previous, keys.Left):
 			// Back not available on finale
 			if m.step != StepFinale {
 				m.back()
@@ -169,26 +170,26 @@ func (m FeatureTourModel) getHintsForStep() string {
 	case StepModes:
 		// First step: no back button
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "S", Action: "Skip"},
-			{Key: "→", Action: "Continue"},
+			components.KeyHint("Skip", keys.SkipTour),
+			components.KeyHint("Continue", keys.Right),
 		}, m.width)
 	case StepStatistics:
 		// Last feature step: no skip (already at the end)
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "←", Action: "Back"},
-			{Key: "→", Action: "Continue"},
+			components.KeyHint("Back", keys.Left),
+			components.KeyHint("Continue", keys.Right),
 		}, m.width)
 	case StepFinale:
 		// Finale: only "Let's go" - no back or skip
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "→", Action: "Let's go"},
+			components.KeyHint("Let's go", keys.Right),
 		}, m.width)
 	default:
 		// Middle steps: back, skip, continue
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "←", Action: "Back"},
-			{Key: "S", Action: "Skip"},
-			{Key: "→", Action: "Continue"},
+			components.KeyHint("Back", keys.Left),
+			components.KeyHint("Skip", keys.SkipTour),
+			components.KeyHint("Continue", keys.Right),
 		}, m.width)
 	}
 }
@@ -245,23 +246,23 @@ func (m FeatureTourModel) getViewportContent() string {
 
 // renderModesContent renders the game modes introduction with grouped list.
 func (m FeatureTourModel) renderModesContent() string {
-	title := styles.Logo.Render("GAME MODES")
+	title := styles.Current().Logo.Render("GAME MODES")
 
-	subtitle := styles.Subtle.Render("16 modes. Two categories. Pick your challenge.")
+	subtitle := styles.Current().Subtle.Render("16 modes. Two categories. Pick your challenge.")
 
 	// Build the grouped modes box
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(styles.Current().Border).
 		Padding(0, 2)
 
 	// Sprint modes (single operations)
-	sprintLabel := styles.Bold.Render("SPRINT")
-	sprintModes := styles.Dim.Render("+  −  ×  ÷  x²  x³  √x  ³√x  xⁿ  mod  %  n!")
+	sprintLabel := styles.Current().Bold.Render("SPRINT")
+	sprintModes := styles.Current().Dim.Render("+  −  ×  ÷  x²  x³  √x  ³√x  xⁿ  mod  %  n!")
 
 	// Challenge modes (mixed operations)
-	challengeLabel := styles.Bold.Render("CHALLENGE")
-	challengeModes := styles.Dim.Render("Mixed Basics · Mixed Powers · Mixed Advanced · Anything Goes")
+	challengeLabel := styles.Current().Bold.Render("CHALLENGE")
+	challengeModes := styles.Current().Dim.Render("Mixed Basics · Mixed Powers · Mixed Advanced · Anything Goes")
 
 	modesContent := lipgloss.JoinVertical(lipgloss.Left,
 		sprintLabel,
@@ -292,10 +293,10 @@ func (m FeatureTourModel) renderModesContent() string {
 
 // renderPracticeContent renders the practice mode introduction.
 func (m FeatureTourModel) renderPracticeContent() string {
-	title := styles.Logo.Render("PRACTICE MODE")
+	title := styles.Current().Logo.Render("PRACTICE MODE")
 
 	description := lipgloss.JoinVertical(lipgloss.Center,
-		styles.Subtle.Render("No timer. No pressure."),
+		styles.Current().Subtle.Render("No timer. No pressure."),
 		"",
 		"Pick any operation and practice",
 		"at your own pace.",
@@ -327,14 +328,14 @@ func (m FeatureTourModel) renderPracticePreview() string {
 
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(styles.Current().Border).
 		Width(previewBoxWidth)
 
 	centerStyle := lipgloss.NewStyle().Width(previewBoxWidth).Align(lipgloss.Center)
 
-	header := centerStyle.Render(styles.Dim.Render("Basic · Addition · Medium"))
-	question := centerStyle.Render(styles.Bold.Render("15 + 8 = ?"))
-	input := centerStyle.Render(styles.Dim.Render("> ") + styles.Accent.Render("23") + styles.Dim.Render("█"))
+	header := centerStyle.Render(styles.Current().Dim.Render("Basic · Addition · Medium"))
+	question := centerStyle.Render(styles.Current().Bold.Render("15 + 8 = ?"))
+	input := centerStyle.Render(styles.Current().Dim.Render("> ") + styles.Current().Accent.Render("23") + styles.Current().Dim.Render("█"))
 
 	inner := lipgloss.JoinVertical(lipgloss.Left,
 		header,
@@ -349,15 +350,15 @@ func (m FeatureTourModel) renderPracticePreview() string {
 
 // renderStatisticsContent renders the statistics introduction.
 func (m FeatureTourModel) renderStatisticsContent() string {
-	title := styles.Logo.Render("STATISTICS")
+	title := styles.Current().Logo.Render("STATISTICS")
 
 	description := lipgloss.JoinVertical(lipgloss.Center,
-		styles.Subtle.Render("Every session counts."),
+		styles.Current().Subtle.Render("Every session counts."),
 		"",
 		"Track accuracy, streaks, and response",
 		"times across all modes.",
 		"",
-		styles.Dim.Render("Dive into history, trends, and session details."),
+		styles.Current().Dim.Render("Dive into history, trends, and session details."),
 	)
 
 	preview := m.renderStatisticsPreview()
@@ -386,14 +387,14 @@ func (m FeatureTourModel) renderStatisticsPreview() string {
 
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(styles.Current().Border).
 		Width(previewBoxWidth).
 		Padding(0, 1)
 
 	// Mock operation rows matching real dashboard format: symbol Name  XX%  bar
-	row1 := "+  Addition        " + styles.Correct.Render("92%") + "  " + styles.Correct.Render("█████████") + styles.Dim.Render("░")
-	row2 := "−  Subtraction     " + styles.Correct.Render("85%") + "  " + styles.Correct.Render("████████") + styles.Dim.Render("░░")
-	row3 := "×  Multiplication  " + "68%" + "  " + "██████" + styles.Dim.Render("░░░░")
+	row1 := "+  Addition        " + styles.Current().Correct.Render("92%") + "  " + styles.Current().Correct.Render("█████████") + styles.Current().Dim.Render("░")
+	row2 := "−  Subtraction     " + styles.Current().Correct.Render("85%") + "  " + styles.Current().Correct.Render("████████") + styles.Current().Dim.Render("░░")
+	row3 := "×  Multiplication  " + "68%" + "  " + "██████" + styles.Current().Dim.Render("░░░░")
 
 	inner := lipgloss.JoinVertical(lipgloss.Left,
 		"",
@@ -408,8 +409,8 @@ func (m FeatureTourModel) renderStatisticsPreview() string {
 
 // renderFinaleContent renders the finale screen with creator attribution.
 func (m FeatureTourModel) renderFinaleContent() string {
-	byLine := styles.Dim.Render("A game by ") + styles.Accent.Render("@gurselcakar")
-	claudeLine := styles.Dim.Render("+ Claude Code")
+	byLine := styles.Current().Dim.Render("A game by ") + styles.Current().Accent.Render("@gurselcakar")
+	claudeLine := styles.Current().Dim.Render("+ Claude Code")
 
 	content := lipgloss.JoinVertical(lipgloss.Center,
 		byLine,
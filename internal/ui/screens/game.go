@@ -1,6 +1,7 @@
 package screens
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -19,6 +21,10 @@ const (
 	milestoneShowTime = 2 * time.Second
 )
 
+// countdownInterval is how often the question timer is checked and its
+// bar redrawn.
+const countdownInterval = 100 * time.Millisecond
+
 // Score animation constants
 const (
 	// scoreAnimInterval: 30ms = ~33 FPS, provides smooth visual updates without excessive CPU use
@@ -48,10 +54,23 @@ type GameModel struct {
 	deltaExpiry     time.Time // when delta popup should clear
 	milestone       string    // milestone text (e.g., "×1.25", "×2.0 MAX")
 	milestoneExpiry time.Time // when milestone should clear
+	timeUp          bool      // last question ran out of time (replaces the delta popup)
 
 	// Score animation state
 	displayScore int  // currently displayed score (animates toward actual)
 	animating    bool // whether score animation is in progress
+
+	// Best earlier run to race against (nil if none)
+	ghost *game.Ghost
+
+	// Flash Anzan state: a flashed question shows its numbers one at a
+	// time, and only takes an answer once the sequence has played
+	flashIndex int  // index of the number on screen
+	flashDone  bool // whether the current sequence has finished
+	flashID    int  // tags flash ticks so stale ones are ignored
+
+	// Question timer ticks, tagged so a resumed game runs a single loop
+	countdownID int
 }
 
 // NewGame creates a new game model with the given session and input method.
@@ -77,6 +96,7 @@ func (m GameModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.input.Init(),
 		TickCmd(),
+		m.countdownCmd(),
 		func() tea.Msg { return gameStartMsg{} }, // Trigger choice generation in Update
 	)
 }
@@ -101,6 +121,36 @@ func ScoreAnimCmd() tea.Cmd {
 	})
 }
 
+// flashMsg advances a flashed sequence to its next number.
+type flashMsg struct {
+	id int
+}
+
+// flashCmd returns a command that advances the flash with the given id
+// after interval.
+func flashCmd(id int, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return flashMsg{id: id}
+	})
+}
+
+// countdownMsg checks the question timer.
+type countdownMsg struct {
+	id int
+}
+
+// countdownCmd returns a command that checks the question timer after
+// countdownInterval, or nil when the timer is off.
+func (m GameModel) countdownCmd() tea.Cmd {
+	if m.session.QuestionLimit <= 0 {
+		return nil
+	}
+	id := m.countdownID
+	return tea.Tick(countdownInterval, func(time.Time) tea.Msg {
+		return countdownMsg{id: id}
+	})
+}
+
 // GameOverMsg is sent when the game session ends.
 type GameOverMsg struct {
 	Session *game.Session
@@ -125,7 +175,7 @@ func (m GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 			choices, correctIndex := game.GenerateChoices(m.session.Current.Answer, m.session.Difficulty)
 			m.choices.SetChoices(choices, correctIndex)
 		}
-		return m, nil
+		return m, m.startFlash()
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -152,8 +202,9 @@ func (m GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 
 		// Clear expired display states
 		now := time.Now()
-		if m.scoreDelta != 0 && now.After(m.deltaExpiry) {
+		if (m.scoreDelta != 0 || m.timeUp) && now.After(m.deltaExpiry) {
 			m.scoreDelta = 0
+			m.timeUp = false
 		}
 		if m.milestone != "" && now.After(m.milestoneExpiry) {
 			m.milestone = ""
@@ -197,23 +248,53 @@ func (m GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 
 		return m, ScoreAnimCmd()
 
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter":
-			if m.inputMethod == components.InputTyping {
-				return m.submitAnswer()
-			}
+	case countdownMsg:
+		if msg.id != m.countdownID || m.session.IsFinished() {
+			return m, nil
+		}
+		// The timer starts once a flashed sequence has played
+		if !m.flashing() && m.session.QuestionExpired() {
+			var cmd tea.Cmd
+			m, cmd = m.skipQuestion(true)
+			return m, tea.Batch(cmd, m.countdownCmd())
+		}
+		return m, m.countdownCmd()
+
+	case flashMsg:
+		if msg.id != m.flashID || !m.flashing() {
 			return m, nil
-		case "s", " ":
-			return m.skipQuestion()
-		case "p":
+		}
+		m.flashIndex++
+		if m.flashIndex < len(m.session.Current.Flash.Numbers) {
+			return m, flashCmd(m.flashID, m.session.Current.Flash.Interval)
+		}
+		// Sequence over: the answer clock starts now
+		m.flashDone = true
+		m.session.StartAnswer()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case keys.Matches(msg, keys.Skip):
+			return m.skipQuestion(false)
+		case keys.Matches(msg, keys.Pause):
+			m.session.Pause()
 			return m, func() tea.Msg {
 				return PauseMsg{Session: m.session}
 			}
-		case "q", "esc":
+		case keys.Matches(msg, keys.Quit):
+			m.session.Pause()
 			return m, func() tea.Msg {
 				return QuitConfirmMsg{Session: m.session}
 			}
+		case m.flashing():
+			// No answers until the whole sequence has been shown
+			return m, nil
+		case keys.Matches(msg, keys.Submit):
+			if m.inputMethod == components.InputTyping {
+				return m.submitAnswer()
+			}
+			return m, nil
 		default:
 			// Route to appropriate input component
 			if m.inputMethod == components.InputMultipleChoice {
@@ -259,10 +340,13 @@ func (m GameModel) submitAnswerValue(answer int) (GameModel, tea.Cmd) {
 	var cmd tea.Cmd
 	if m.session.LastResult != nil {
 		m.scoreDelta = m.session.LastResult.Points
+		m.timeUp = false
 		m.deltaExpiry = time.Now().Add(deltaDisplayTime)
 
-		// Start score animation
-		if m.session.Score != m.displayScore {
+		// Start score animation; accessible mode shows the new score at once
+		if styles.Accessible() {
+			m.displayScore = m.session.Score
+		} else if m.session.Score != m.displayScore {
 			m.animating = true
 			cmd = ScoreAnimCmd()
 		}
@@ -289,16 +373,25 @@ func (m GameModel) submitAnswerValue(answer int) (GameModel, tea.Cmd) {
 		m.choices.SetChoices(choices, correctIndex)
 	}
 
-	return m, cmd
+	return m, tea.Batch(cmd, m.startFlash())
 }
 
-// skipQuestion skips the current question.
-func (m GameModel) skipQuestion() (GameModel, tea.Cmd) {
-	m.session.Skip()
+// skipQuestion skips the current question, by the player or because the
+// question timer ran out.
+func (m GameModel) skipQuestion(timedOut bool) (GameModel, tea.Cmd) {
+	if timedOut {
+		m.session.TimeOut()
+	} else {
+		m.session.Skip()
+	}
 	m.input.Reset()
 	m.choices.Reset()
 	m.scoreDelta = 0
 	m.deltaExpiry = time.Time{}
+	m.timeUp = timedOut
+	if timedOut {
+		m.deltaExpiry = time.Now().Add(deltaDisplayTime)
+	}
 	// Sync animation state (skip doesn't change score, but stop any in-progress animation)
 	m.animating = false
 	m.displayScore = m.session.Score
@@ -309,7 +402,33 @@ func (m GameModel) skipQuestion() (GameModel, tea.Cmd) {
 		m.choices.SetChoices(choices, correctIndex)
 	}
 
-	return m, nil
+	return m, m.startFlash()
+}
+
+// startFlash starts playing the current question's sequence, if it has
+// one. Ticks of an earlier sequence are ignored from then on.
+func (m *GameModel) startFlash() tea.Cmd {
+	m.flashID++
+	m.flashIndex = 0
+	m.flashDone = false
+	if m.session.Current == nil || m.session.Current.Flash == nil {
+		return nil
+	}
+	return flashCmd(m.flashID, m.session.Current.Flash.Interval)
+}
+
+// flashing reports whether a sequence is still being shown.
+func (m GameModel) flashing() bool {
+	return m.session.Current != nil && m.session.Current.Flash != nil && !m.flashDone
+}
+
+// questionText returns the current question as shown once it can be
+// answered. A flashed question asks for the total instead of showing it.
+func (m GameModel) questionText() string {
+	if flash := m.session.Current.Flash; flash != nil {
+		return fmt.Sprintf("Total of %d numbers", len(flash.Numbers))
+	}
+	return components.QuestionText(*m.session.Current)
 }
 
 // View renders the game screen.
@@ -320,44 +439,20 @@ func (m GameModel) View() string {
 	if m.session == nil {
 		return "Loading..."
 	}
+	if styles.Accessible() {
+		return m.viewAccessible()
+	}
+
+	if components.IsCompact(m.width, m.height) {
+		return m.viewCompact()
+	}
 
 	// Build top row: scoreboard (left) | score+delta (center) | timer (right)
 	topRow := m.renderTopRow()
 
-	// Question (center)
-	var question string
-	if m.session.Current != nil {
-		if m.inputMethod == components.InputMultipleChoice {
-			question = components.RenderQuestionWithAnswer(m.session.Current.Display)
-		} else {
-			question = components.RenderQuestion(m.session.Current.Display)
-		}
-	}
-
-	// Input area
-	var inputView string
-	if m.inputMethod == components.InputMultipleChoice {
-		inputView = m.choices.View()
-	} else {
-		inputView = m.input.View()
-	}
-
-	// Hints - differ based on input method
-	var hints string
-	if m.inputMethod == components.InputMultipleChoice {
-		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "1-4", Action: "Select"},
-			{Key: "S", Action: "Skip"},
-			{Key: "P", Action: "Pause"},
-			{Key: "Esc", Action: "Quit"},
-		}, m.width)
-	} else {
-		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "S", Action: "Skip"},
-			{Key: "P", Action: "Pause"},
-			{Key: "Esc", Action: "Quit"},
-		}, m.width)
-	}
+	question := m.renderQuestion()
+	inputView := m.renderInput()
+	hints := components.RenderHintsResponsive(m.hints(), m.width)
 
 	// Center content (milestone is now shown above score in top row)
 	centerContent := lipgloss.JoinVertical(lipgloss.Center,
@@ -365,6 +460,9 @@ func (m GameModel) View() string {
 		"",
 		inputView,
 	)
+	if bar := m.renderCountdown(); bar != "" {
+		centerContent = lipgloss.JoinVertical(lipgloss.Center, centerContent, "", bar)
+	}
 
 	// Layout with bottom-anchored hints and small gap at bottom
 	if m.width > 0 && m.height > 0 {
@@ -401,6 +499,163 @@ func (m GameModel) View() string {
 	return b.String()
 }
 
+// viewAccessible renders the game screen for accessible mode: one
+// labeled line per item, top to bottom, so a screen reader reads it in
+// order and the result of the last answer is spelled out.
+func (m GameModel) viewAccessible() string {
+	lines := []string{
+		"Score " + components.RenderScore(m.session.Score),
+		components.ScoreboardText(m.session.Streak),
+		"Time left " + components.FormatTimer(m.session.TimeLeft),
+	}
+	if m.ghost != nil {
+		lines = append(lines, components.RenderGhostRace(m.session.Score, m.ghost.ScoreAt(m.session.Elapsed()), m.ghost.FinalScore(), 0))
+	}
+	if n := len(m.session.History); n > 0 {
+		lines = append(lines, components.AnswerFeedback(m.session.History[n-1]))
+	}
+	lines = append(lines, "")
+	if m.session.QuestionLimit > 0 && !m.flashing() {
+		// Whole seconds, so a screen reader isn't flooded with updates
+		left := m.session.QuestionTimeLeft().Round(time.Second)
+		lines = append(lines, fmt.Sprintf("Question time left %ds", int(left.Seconds())))
+	}
+
+	if m.flashing() {
+		n := m.session.Current.Flash.Numbers[m.flashIndex]
+		lines = append(lines, components.RenderQuestion(fmt.Sprintf("%s: %d", m.flashProgress(), n)))
+	} else if m.session.Current != nil {
+		if m.inputMethod == components.InputMultipleChoice {
+			lines = append(lines, components.RenderQuestionWithAnswer(m.questionText()), m.choices.View())
+		} else {
+			lines = append(lines, components.RenderQuestion(m.questionText()), m.input.View())
+		}
+	}
+
+	lines = append(lines, "", components.RenderHintsResponsive(m.hints(), m.width))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// viewCompact renders the game screen for small terminals: a one-line
+// HUD, a status line for the last answer, the question and input in the
+// middle, and abbreviated hints on the last line.
+func (m GameModel) viewCompact() string {
+	hud := m.renderCompactHUD()
+	status := lipgloss.PlaceHorizontal(m.width, lipgloss.Center, m.renderCompactStatus())
+	hints := components.RenderHintsCompact(m.hints(), m.width)
+
+	center := []string{m.renderQuestion(), m.renderInput()}
+	if bar := m.renderCountdown(); bar != "" {
+		center = append(center, bar)
+	}
+	centerContent := lipgloss.JoinVertical(lipgloss.Center, center...)
+
+	availableHeight := m.height - lipgloss.Height(hud) - lipgloss.Height(status) - components.CompactHintsHeight
+	if availableHeight < 1 {
+		availableHeight = 1
+	}
+	centered := lipgloss.Place(m.width, availableHeight, lipgloss.Center, lipgloss.Center, centerContent)
+
+	return lipgloss.JoinVertical(lipgloss.Left, hud, status, centered, hints)
+}
+
+// renderCompactHUD renders multiplier, score and timer on a single line,
+// spread across the width.
+func (m GameModel) renderCompactHUD() string {
+	multiplier := components.RenderMultiplier(game.StreakBonus(m.session.Streak))
+	scoreValue := m.displayScore
+	if !m.animating {
+		scoreValue = m.session.Score
+	}
+	score := components.RenderScoreLarge(scoreValue)
+	timer := components.FormatTimer(m.session.TimeLeft)
+
+	side := (m.width - lipgloss.Width(score)) / 2
+	if side < 0 {
+		side = 0
+	}
+	left := lipgloss.NewStyle().Width(side).Align(lipgloss.Left).Render(" " + multiplier)
+	right := lipgloss.NewStyle().Width(m.width - side - lipgloss.Width(score)).Align(lipgloss.Right).Render(timer + " ")
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, score, right)
+}
+
+// renderCompactStatus returns the single status line of the compact
+// layout: the last answer's points, the question timer running out, a new
+// milestone, or the race against the ghost, in that order.
+func (m GameModel) renderCompactStatus() string {
+	switch {
+	case m.scoreDelta != 0:
+		return components.RenderScoreDelta(m.scoreDelta)
+	case m.timeUp:
+		return styles.Current().Incorrect.Render("Time's up")
+	case m.milestone != "":
+		return styles.Current().Milestone.Render(m.milestone)
+	case m.ghost != nil:
+		return components.RenderGhostRace(m.session.Score, m.ghost.ScoreAt(m.session.Elapsed()), m.ghost.FinalScore(), m.width-4)
+	default:
+		return ""
+	}
+}
+
+// renderQuestion renders the question, or the number being flashed.
+func (m GameModel) renderQuestion() string {
+	switch {
+	case m.flashing():
+		return components.RenderQuestion(strconv.Itoa(m.session.Current.Flash.Numbers[m.flashIndex]))
+	case m.session.Current == nil:
+		return ""
+	case m.inputMethod == components.InputMultipleChoice:
+		return components.RenderQuestionWithAnswer(m.questionText())
+	default:
+		return components.RenderQuestion(m.questionText())
+	}
+}
+
+// renderInput renders the answer input; while flashing, how far into the
+// sequence it is.
+func (m GameModel) renderInput() string {
+	switch {
+	case m.flashing():
+		return styles.Current().Dim.Render(m.flashProgress())
+	case m.inputMethod == components.InputMultipleChoice:
+		return m.choices.View()
+	default:
+		return m.input.View()
+	}
+}
+
+// hints returns the key hints for the game screen; choices can only be
+// picked once a flashed sequence has finished.
+func (m GameModel) hints() []components.Hint {
+	hints := []components.Hint{
+		components.KeyHint("Skip", keys.Skip),
+		components.KeyHint("Pause", keys.Pause),
+		components.KeyHint("Quit", keys.Quit),
+	}
+	if m.inputMethod == components.InputMultipleChoice && !m.flashing() {
+		hints = append([]components.Hint{components.KeyHint("Select", keys.Choices...)}, hints...)
+	}
+	return hints
+}
+
+// renderCountdown renders the question timer as a shrinking bar with the
+// seconds left, or "" when the timer is off or a sequence is flashing.
+func (m GameModel) renderCountdown() string {
+	if m.session.QuestionLimit <= 0 || m.flashing() {
+		return ""
+	}
+	left := m.session.QuestionTimeLeft()
+	bar := components.RenderCountdownBar(left, m.session.QuestionLimit, components.ProgressBarWidth(m.width))
+	return bar + " " + styles.Current().Dim.Render(fmt.Sprintf("%4.1fs", left.Seconds()))
+}
+
+// flashProgress returns how far into the flashed sequence the number on
+// screen is, e.g. "Number 3 of 5".
+func (m GameModel) flashProgress() string {
+	return fmt.Sprintf("Number %d of %d", m.flashIndex+1, len(m.session.Current.Flash.Numbers))
+}
+
 // renderTopRow renders the top status bar with scoreboard, score, and timer.
 func (m GameModel) renderTopRow() string {
 	if m.width < 40 {
@@ -420,7 +675,7 @@ func (m GameModel) renderTopRow() string {
 	// Right: Timer with "remaining" label
 	timer := components.FormatTimer(m.session.TimeLeft)
 	timerWithLabel := lipgloss.JoinVertical(lipgloss.Right,
-		styles.Dim.Render("Remaining"),
+		styles.Current().Dim.Render("Remaining"),
 		timer,
 	)
 
@@ -436,6 +691,11 @@ func (m GameModel) renderTopRow() string {
 	rightCol := lipgloss.NewStyle().Width(rightWidth).Align(lipgloss.Right).Render(timerWithLabel)
 
 	row := lipgloss.JoinHorizontal(lipgloss.Top, leftCol, centerCol, rightCol)
+	if m.ghost != nil {
+		race := components.RenderGhostRace(m.session.Score, m.ghost.ScoreAt(m.session.Elapsed()), m.ghost.FinalScore(), centerWidth)
+		raceRow := lipgloss.NewStyle().Width(hudWidth).Align(lipgloss.Center).Render(race)
+		row = lipgloss.JoinVertical(lipgloss.Left, row, raceRow)
+	}
 	return lipgloss.NewStyle().PaddingLeft(margin).Render(row)
 }
 
@@ -450,13 +710,19 @@ func (m GameModel) renderTopRowSimple() string {
 	score := components.RenderScore(scoreValue)
 	timer := components.FormatTimer(m.session.TimeLeft)
 
-	return lipgloss.JoinHorizontal(lipgloss.Top,
+	row := lipgloss.JoinHorizontal(lipgloss.Top,
 		scoreboard,
 		"    ",
 		score,
 		"    ",
 		timer,
 	)
+	if m.ghost != nil {
+		// Too narrow for a track; show only how far ahead or behind.
+		race := components.RenderGhostRace(m.session.Score, m.ghost.ScoreAt(m.session.Elapsed()), m.ghost.FinalScore(), 0)
+		row = lipgloss.JoinVertical(lipgloss.Center, row, race)
+	}
+	return row
 }
 
 // renderScoreWithDelta renders the score with label, delta popup, and score number.
@@ -476,17 +742,19 @@ func (m GameModel) renderScoreWithDelta() string {
 
 	// Top line: milestone replaces "Score" label when active
 	if m.milestone != "" {
-		parts = append(parts, styles.Milestone.Render(m.milestone))
+		parts = append(parts, styles.Current().Milestone.Render(m.milestone))
 	} else {
-		parts = append(parts, styles.Dim.Render("Score"))
+		parts = append(parts, styles.Current().Dim.Render("Score"))
 	}
 
 	// Score number
 	parts = append(parts, score)
 
-	// Delta popup (+150 or -25)
+	// Delta popup (+150 or -25), or the question timer running out
 	if m.scoreDelta != 0 {
 		parts = append(parts, components.RenderScoreDelta(m.scoreDelta))
+	} else if m.timeUp {
+		parts = append(parts, styles.Current().Incorrect.Render("Time's up"))
 	} else {
 		parts = append(parts, "") // empty line for consistent height
 	}
@@ -508,6 +776,24 @@ func (m *GameModel) SetSession(session *game.Session) {
 	m.session = session
 }
 
+// Resume restarts the timers after a pause. A flashed sequence carries on
+// from the number that was on screen.
+func (m *GameModel) Resume() tea.Cmd {
+	m.session.Resume()
+	m.countdownID++
+	cmd := tea.Batch(TickCmd(), m.countdownCmd())
+	if m.flashing() {
+		m.flashID++
+		cmd = tea.Batch(cmd, flashCmd(m.flashID, m.session.Current.Flash.Interval))
+	}
+	return cmd
+}
+
+// SetGhost sets the best earlier run to race against. A nil ghost hides the race.
+func (m *GameModel) SetGhost(ghost *game.Ghost) {
+	m.ghost = ghost
+}
+
 // SetSize sets the screen dimensions.
 func (m *GameModel) SetSize(width, height int) {
 	m.width = width
@@ -0,0 +1,129 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+func TestGameAccessibleView(t *testing.T) {
+	styles.SetAccessible(true)
+	defer styles.SetAccessible(false)
+
+	s := game.NewSession(&listGenerator{}, game.Easy, time.Minute)
+	m := NewGame(s, components.InputTyping)
+	m.Init()
+	m.SetSize(80, 24)
+	missed := s.Current.Answer
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("accessible mode should not start the score animation")
+	}
+
+	view := m.View()
+	for _, want := range []string{"Score 0", "Multiplier 1.0, streak 0", "Time left 01:00", fmt.Sprintf("Wrong, the answer was %d", missed), s.Current.Display} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+// flashGenerator flashes 4, 7, 2 and expects their total.
+type flashGenerator struct{}
+
+func (flashGenerator) Generate(diff game.Difficulty) *game.Question {
+	return &game.Question{
+		Key:     "flash",
+		OpLabel: "Flash Anzan",
+		Answer:  13,
+		Display: "4 + 7 + 2",
+		Flash:   &game.FlashSequence{Numbers: []int{4, 7, 2}, Interval: time.Second},
+	}
+}
+
+func (flashGenerator) Label() string { return "Flash Anzan" }
+
+func TestGameFlashSequence(t *testing.T) {
+	s := game.NewSession(flashGenerator{}, game.Medium, time.Minute)
+	m := NewGame(s, components.InputTyping)
+	m.Init()
+	m.SetSize(80, 24)
+	m, cmd := m.Update(gameStartMsg{})
+	if cmd == nil {
+		t.Fatal("a flashed question should start the flash ticks")
+	}
+
+	for _, n := range []string{"4", "7", "2"} {
+		view := m.View()
+		if !strings.Contains(view, n) || strings.Contains(view, "Total") {
+			t.Errorf("view should flash %s:\n%s", n, view)
+		}
+		// Typing is ignored while the sequence plays
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+		m, _ = m.Update(flashMsg{id: m.flashID})
+	}
+
+	if !strings.Contains(m.View(), "Total of 3 numbers") {
+		t.Errorf("view should ask for the total:\n%s", m.View())
+	}
+	if m.input.Value() != "" {
+		t.Errorf("input = %q, keys during the flash should be ignored", m.input.Value())
+	}
+
+	// A tick of the finished sequence does nothing
+	m, _ = m.Update(flashMsg{id: m.flashID - 1})
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("13")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if s.Correct != 1 {
+		t.Errorf("Correct = %d, want 1", s.Correct)
+	}
+	if m.flashIndex != 0 || !m.flashing() {
+		t.Error("the next question should start flashing")
+	}
+}
+
+func TestGameQuestionTimer(t *testing.T) {
+	s := game.NewSession(&listGenerator{}, game.Hard, time.Minute)
+	s.QuestionLimit = game.QuestionTimeLimit(game.Hard)
+	m := NewGame(s, components.InputTyping)
+	m.Init()
+	m.SetSize(80, 24)
+
+	if !strings.Contains(m.View(), "5.0s") {
+		t.Errorf("view should show the question timer:\n%s", m.View())
+	}
+
+	// A tick before the limit keeps the question
+	m, cmd := m.Update(countdownMsg{id: m.countdownID})
+	if cmd == nil || len(s.History) != 0 {
+		t.Fatal("the timer should keep ticking without skipping")
+	}
+
+	s.QuestionStart = time.Now().Add(-s.QuestionLimit)
+	m, _ = m.Update(countdownMsg{id: m.countdownID})
+	if len(s.History) != 1 || !s.History[0].TimedOut {
+		t.Fatalf("history = %+v, want one timed-out question", s.History)
+	}
+	if !strings.Contains(m.View(), "Time's up") {
+		t.Errorf("view should say time is up:\n%s", m.View())
+	}
+
+	// Ticks from before a resume are dropped
+	stale := m.countdownID
+	m.Resume()
+	s.QuestionStart = time.Now().Add(-s.QuestionLimit)
+	m, cmd = m.Update(countdownMsg{id: stale})
+	if cmd != nil || len(s.History) != 1 {
+		t.Error("a stale tick should be ignored")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -26,6 +27,7 @@ const (
 	ActionPractice
 	ActionStatistics
 	ActionSettings
+	ActionProfile
 	ActionX
 )
 
@@ -39,6 +41,7 @@ type MenuModel struct {
 	quitting         bool
 	updateVersion    string // Available update version (empty if none)
 	updateInstalled  string // Auto-updated version awaiting restart (empty if none)
+	warning          string // Problem with the user's setup (empty if none)
 	viewport         viewport.Model
 	viewportReady    bool
 }
@@ -76,16 +79,16 @@ func (m MenuModel) Update(msg tea.Msg) (MenuModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		switch {
+		case keys.Matches(msg, keys.Up):
 			m.moveCursor(-1)
 			m.updateViewportContent()
-		case "down", "j":
+		case keys.Matches(msg, keys.Down):
 			m.moveCursor(1)
 			m.updateViewportContent()
-		case "enter", "right", "l":
+		case keys.Matches(msg, keys.Select, keys.Right):
 			return m, m.selectItem()
-		case "esc", "ctrl+c", "q":
+		case msg.String() == "ctrl+c", keys.Matches(msg, keys.Quit):
 			m.quitting = true
 			return m, tea.Quit
 		}
@@ -156,19 +159,25 @@ func (m MenuModel) View() string {
 // getHints returns the hints for the menu.
 func (m MenuModel) getHints() string {
 	hints := components.RenderHintsResponsive([]components.Hint{
-		{Key: "↑↓", Action: "Navigate"},
-		{Key: "→", Action: "Select"},
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Select", keys.Right),
 	}, m.width)
 
+	// Setup problems take priority over update notices
+	if m.warning != "" {
+		warning := styles.Current().Incorrect.MaxWidth(m.width).Render(m.warning)
+		return lipgloss.JoinVertical(lipgloss.Center, hints, "", warning)
+	}
+
 	// Auto-update installed notification (takes priority)
 	if m.updateInstalled != "" {
-		updateNotice := styles.Correct.Render("Updated to " + m.updateInstalled + " — restart to apply")
+		updateNotice := styles.Current().Correct.Render("Updated to " + m.updateInstalled + " — restart to apply")
 		return lipgloss.JoinVertical(lipgloss.Center, hints, "", updateNotice)
 	}
 
 	// Manual update notification (fallback)
 	if m.updateVersion != "" {
-		updateNotice := styles.Dim.Render("Update available: " + m.updateVersion + " · run 'arithmego update'")
+		updateNotice := styles.Current().Dim.Render("Update available: " + m.updateVersion + " · run 'arithmego update'")
 		return lipgloss.JoinVertical(lipgloss.Center, hints, "", updateNotice)
 	}
 
@@ -215,7 +224,7 @@ func (m *MenuModel) updateViewportContent() {
 func (m MenuModel) renderMenuContent() string {
 	// Logo with color
 	logo := components.LogoColoredForWidth(m.width)
-	separator := styles.Dim.Render(components.LogoSeparator())
+	separator := styles.Current().Dim.Render(components.LogoSeparator())
 	tagline := components.Tagline()
 
 	// Split menu items into main (game) items and secondary items
@@ -230,9 +239,9 @@ func (m MenuModel) renderMenuContent() string {
 
 		var line string
 		if i == m.cursor {
-			line = styles.Accent.Render("> ") + styles.Selected.Render(item.Label)
+			line = styles.Current().Accent.Render("> ") + styles.Current().Selected.Render(item.Label)
 		} else {
-			line = "  " + styles.Unselected.Render(item.Label)
+			line = "  " + styles.Current().Unselected.Render(item.Label)
 		}
 		if isSecondary {
 			secondaryItems = append(secondaryItems, line)
@@ -285,6 +294,33 @@ func (m *MenuModel) SetUpdateInfo(version string) {
 	m.updateVersion = version
 }
 
+// SetProfile shows the active profile as a menu item that opens the switcher.
+// Pass an empty name to hide it (e.g. when only the default profile exists).
+func (m *MenuModel) SetProfile(name string) {
+	// Drop any existing profile item, then insert one after the spacer.
+	items := make([]MenuItem, 0, len(m.items)+1)
+	for _, item := range m.items {
+		if item.Action == ActionProfile && !item.IsSpacer {
+			continue
+		}
+		items = append(items, item)
+		if item.IsSpacer && name != "" {
+			items = append(items, MenuItem{Label: "Profile · " + name, Action: ActionProfile})
+		}
+	}
+	m.items = items
+	if m.cursor >= len(m.items) {
+		m.cursor = 0
+	}
+	m.updateViewportContent()
+}
+
+// SetWarning shows a problem with the user's setup below the hints.
+// Pass an empty text to hide it.
+func (m *MenuModel) SetWarning(text string) {
+	m.warning = text
+}
+
 // SetUpdateInstalled sets the auto-updated version for display.
 func (m *MenuModel) SetUpdateInstalled(version string) {
 	m.updateInstalled = version
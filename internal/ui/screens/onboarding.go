@@ -1,12 +1,15 @@
 package screens
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/modes"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -133,24 +136,24 @@ func (m OnboardingModel) Update(msg tea.Msg) (OnboardingModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
+		switch {
+		case msg.String() == "ctrl+c":
 			return m, tea.Quit
-		case "up", "k":
+		case keys.Matches(msg, keys.Up):
 			m.moveSelection(-1)
 			m.updateViewportContent()
 			m.scrollToSelection()
-		case "down", "j":
+		case keys.Matches(msg, keys.Down):
 			m.moveSelection(1)
 			m.updateViewportContent()
 			m.scrollToSelection()
-		case "enter", "right", "l":
+		case keys.Matches(msg, keys.Select, keys.Right):
 			newModel, cmd := m.advance()
 			newModel.updateViewportContent()
 			return newModel, cmd
-		case "s", "S":
+		case keys.Matches(msg, keys.SkipTour):
 			return m.skip()
-		case "b", "B", "left", "h":
+		case keys.Matches(msg, keys.Previous, keys.Left):
 			m.back()
 			m.updateViewportContent()
 		}
@@ -327,20 +330,20 @@ func (m OnboardingModel) getHintsForStep() string {
 	switch m.step {
 	case StepWelcome:
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "S", Action: "Skip"},
-			{Key: "→", Action: "Continue"},
+			components.KeyHint("Skip", keys.SkipTour),
+			components.KeyHint("Continue", keys.Right),
 		}, m.width)
 	case StepReady:
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "←", Action: "Back"},
-			{Key: "→", Action: "Start"},
+			components.KeyHint("Back", keys.Left),
+			components.KeyHint("Start", keys.Right),
 		}, m.width)
 	default:
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "←", Action: "Back"},
-			{Key: "↑↓", Action: "Navigate"},
-			{Key: "S", Action: "Skip"},
-			{Key: "→", Action: "Continue"},
+			components.KeyHint("Back", keys.Left),
+			components.KeyHint("Navigate", keys.Up, keys.Down),
+			components.KeyHint("Skip", keys.SkipTour),
+			components.KeyHint("Continue", keys.Right),
 		}, m.width)
 	}
 }
@@ -441,9 +444,9 @@ func (m OnboardingModel) getViewportContent() string {
 // renderWelcomeContent renders the welcome step content.
 func (m OnboardingModel) renderWelcomeContent() string {
 	logo := components.LogoColoredForWidth(m.width)
-	separator := styles.Dim.Render(components.LogoSeparator())
+	separator := styles.Current().Dim.Render(components.LogoSeparator())
 	tagline := components.Tagline()
-	setup := styles.Tagline.Render("Let's get you set up.")
+	setup := styles.Current().Tagline.Render("Let's get you set up.")
 
 	content := lipgloss.JoinVertical(lipgloss.Center,
 		logo,
@@ -466,15 +469,15 @@ func (m OnboardingModel) renderWelcomeContent() string {
 
 // renderDurationContent renders the duration step content.
 func (m OnboardingModel) renderDurationContent() string {
-	title := styles.Logo.Render("SESSION LENGTH")
-	subtitle := styles.Subtle.Render("How long do you want to play?")
+	title := styles.Current().Logo.Render("SESSION LENGTH")
+	subtitle := styles.Current().Subtle.Render("How long do you want to play?")
 
 	var options []string
 	for i, opt := range durationOptions {
 		if i == m.durationIndex {
-			options = append(options, styles.Accent.Render("> ")+styles.Bold.Render(opt.Label))
+			options = append(options, styles.Current().Accent.Render("> ")+styles.Current().Bold.Render(opt.Label))
 		} else {
-			options = append(options, "  "+styles.Unselected.Render(opt.Label))
+			options = append(options, "  "+styles.Current().Unselected.Render(opt.Label))
 		}
 	}
 	optionsList := lipgloss.JoinVertical(lipgloss.Left, options...)
@@ -484,15 +487,15 @@ func (m OnboardingModel) renderDurationContent() string {
 
 // renderDifficultyContent renders the difficulty step content.
 func (m OnboardingModel) renderDifficultyContent() string {
-	title := styles.Logo.Render("DIFFICULTY")
-	subtitle := styles.Subtle.Render("What difficulty level?")
+	title := styles.Current().Logo.Render("DIFFICULTY")
+	subtitle := styles.Current().Subtle.Render("What difficulty level?")
 
 	var options []string
 	for i, opt := range difficultyOptions {
 		if i == m.difficultyIndex {
-			options = append(options, styles.Accent.Render("> ")+styles.Bold.Render(opt))
+			options = append(options, styles.Current().Accent.Render("> ")+styles.Current().Bold.Render(opt))
 		} else {
-			options = append(options, "  "+styles.Unselected.Render(opt))
+			options = append(options, "  "+styles.Current().Unselected.Render(opt))
 		}
 	}
 	optionsList := lipgloss.JoinVertical(lipgloss.Left, options...)
@@ -502,15 +505,15 @@ func (m OnboardingModel) renderDifficultyContent() string {
 
 // renderOperationContent renders the operation step content.
 func (m OnboardingModel) renderOperationContent() string {
-	title := styles.Logo.Render("OPERATION")
-	subtitle := styles.Subtle.Render("What do you want to play?")
+	title := styles.Current().Logo.Render("OPERATION")
+	subtitle := styles.Current().Subtle.Render("What do you want to play?")
 
 	var options []string
 	for i, opt := range operationOptions {
 		if i == m.operationIndex {
-			options = append(options, styles.Accent.Render("> ")+styles.Bold.Render(opt.Label))
+			options = append(options, styles.Current().Accent.Render("> ")+styles.Current().Bold.Render(opt.Label))
 		} else {
-			options = append(options, "  "+styles.Unselected.Render(opt.Label))
+			options = append(options, "  "+styles.Current().Unselected.Render(opt.Label))
 		}
 	}
 	optionsList := lipgloss.JoinVertical(lipgloss.Left, options...)
@@ -520,15 +523,15 @@ func (m OnboardingModel) renderOperationContent() string {
 
 // renderInputModeContent renders the input mode step content.
 func (m OnboardingModel) renderInputModeContent() string {
-	title := styles.Logo.Render("INPUT MODE")
-	subtitle := styles.Subtle.Render("How do you want to answer?")
+	title := styles.Current().Logo.Render("INPUT MODE")
+	subtitle := styles.Current().Subtle.Render("How do you want to answer?")
 
 	var options []string
 	for i, opt := range inputModeOptions {
 		if i == m.inputModeIndex {
-			options = append(options, styles.Accent.Render("> ")+styles.Bold.Render(opt))
+			options = append(options, styles.Current().Accent.Render("> ")+styles.Current().Bold.Render(opt))
 		} else {
-			options = append(options, "  "+styles.Unselected.Render(opt))
+			options = append(options, "  "+styles.Current().Unselected.Render(opt))
 		}
 	}
 	optionsList := lipgloss.JoinVertical(lipgloss.Left, options...)
@@ -574,31 +577,31 @@ func (m OnboardingModel) renderInputModePreview() string {
 	// Fixed-size box style for consistent layout
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(styles.Current().Border).
 		Width(previewBoxWidth).
 		Height(previewBoxHeight)
 
 	// Get example based on selected operation
 	example := getPreviewForOperation(m.operationIndex)
-	question := styles.Bold.Render(example.question)
+	question := styles.Current().Bold.Render(example.question)
 
 	var input string
 	if m.inputModeIndex == 0 {
 		// Typing preview: prompt, answer, and cursor (matches game screen)
-		prompt := styles.Dim.Render("> ")
-		answer := styles.Accent.Render(example.answer)
-		cursor := styles.Dim.Render("█")
+		prompt := styles.Current().Dim.Render("> ")
+		answer := styles.Current().Accent.Render(example.answer)
+		cursor := styles.Current().Dim.Render("█")
 		input = prompt + answer + cursor
 	} else {
 		// Multiple choice preview: four options (second is correct)
 		input = lipgloss.JoinHorizontal(lipgloss.Center,
-			styles.Dim.Render("[1] ")+example.choices[0],
+			styles.Current().Dim.Render("[1] ")+example.choices[0],
 			"  ",
-			styles.Accent.Render("[2] ")+example.choices[1],
+			styles.Current().Accent.Render("[2] ")+example.choices[1],
 			"  ",
-			styles.Dim.Render("[3] ")+example.choices[2],
+			styles.Current().Dim.Render("[3] ")+example.choices[2],
 			"  ",
-			styles.Dim.Render("[4] ")+example.choices[3],
+			styles.Current().Dim.Render("[4] ")+example.choices[3],
 		)
 	}
 
@@ -688,7 +691,7 @@ func (m OnboardingModel) renderStepContentWithNote(title, subtitle, options, not
 
 // renderReadyContent renders the ready screen with summary and controls.
 func (m OnboardingModel) renderReadyContent() string {
-	title := styles.Logo.Render("READY TO PLAY")
+	title := styles.Current().Logo.Render("READY TO PLAY")
 
 	// Build summary of selections
 	mode := operationOptions[m.operationIndex].Label
@@ -697,30 +700,30 @@ func (m OnboardingModel) renderReadyContent() string {
 	inputMode := inputModeOptions[m.inputModeIndex]
 
 	summary := lipgloss.JoinVertical(lipgloss.Left,
-		styles.Dim.Render("Mode:       ")+styles.Bold.Render(mode),
-		styles.Dim.Render("Difficulty: ")+styles.Bold.Render(difficulty),
-		styles.Dim.Render("Duration:   ")+styles.Bold.Render(duration),
-		styles.Dim.Render("Input:      ")+styles.Bold.Render(inputMode),
+		styles.Current().Dim.Render("Mode:       ")+styles.Current().Bold.Render(mode),
+		styles.Current().Dim.Render("Difficulty: ")+styles.Current().Bold.Render(difficulty),
+		styles.Current().Dim.Render("Duration:   ")+styles.Current().Bold.Render(duration),
+		styles.Current().Dim.Render("Input:      ")+styles.Current().Bold.Render(inputMode),
 	)
 
 	// Build controls section based on input mode
 	var controls string
 	if m.inputModeIndex == 1 { // Multiple Choice
-		controls = lipgloss.JoinVertical(lipgloss.Left,
-			styles.Dim.Render("[1-4] ")+"Select answer",
-			styles.Dim.Render("[S]   ")+"Skip question",
-			styles.Dim.Render("[P]   ")+"Pause game",
-		)
+		controls = renderControls([]components.Hint{
+			components.KeyHint("Select answer", keys.Choices...),
+			components.KeyHint("Skip question", keys.Skip),
+			components.KeyHint("Pause game", keys.Pause),
+		})
 	} else { // Typing
-		controls = lipgloss.JoinVertical(lipgloss.Left,
-			styles.Dim.Render("[Enter] ")+"Submit answer",
-			styles.Dim.Render("[S]     ")+"Skip question",
-			styles.Dim.Render("[P]     ")+"Pause game",
-		)
+		controls = renderControls([]components.Hint{
+			components.KeyHint("Submit answer", keys.Submit),
+			components.KeyHint("Skip question", keys.Skip),
+			components.KeyHint("Pause game", keys.Pause),
+		})
 	}
 
 	controlsSection := lipgloss.JoinVertical(lipgloss.Left,
-		styles.Subtle.Render("Controls:"),
+		styles.Current().Subtle.Render("Controls:"),
 		"",
 		controls,
 	)
@@ -733,7 +736,7 @@ func (m OnboardingModel) renderReadyContent() string {
 		controlsSection,
 	)
 
-	startInstruction := styles.Tagline.Render("Let's see what you've got.")
+	startInstruction := styles.Current().Tagline.Render("Let's see what you've got.")
 
 	// Combine all parts - title centered, info block centered as a unit
 	content := lipgloss.JoinVertical(lipgloss.Center,
@@ -753,3 +756,21 @@ func (m OnboardingModel) renderReadyContent() string {
 	}
 	return content
 }
+
+// renderControls lists game controls with their keys from the active keymap,
+// the keys aligned in a column.
+func renderControls(controls []components.Hint) string {
+	labels := make([]string, len(controls))
+	width := 0
+	for i, c := range controls {
+		labels[i] = "[" + keys.Label(c.Keys...) + "]"
+		width = max(width, lipgloss.Width(labels[i]))
+	}
+
+	lines := make([]string, len(controls))
+	for i, c := range controls {
+		pad := strings.Repeat(" ", width-lipgloss.Width(labels[i])+1)
+		lines[i] = styles.Current().Dim.Render(labels[i]+pad) + c.Action
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -50,12 +51,12 @@ func (m PauseModel) Update(msg tea.Msg) (PauseModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "enter", "p":
+		switch {
+		case keys.Matches(msg, keys.Select, keys.Pause):
 			return m, func() tea.Msg {
 				return ResumeMsg{Session: m.session}
 			}
-		case "q", "esc":
+		case keys.Matches(msg, keys.Quit):
 			return m, func() tea.Msg {
 				return QuitConfirmMsg{Session: m.session}
 			}
@@ -68,15 +69,15 @@ func (m PauseModel) Update(msg tea.Msg) (PauseModel, tea.Cmd) {
 // View renders the pause screen.
 func (m PauseModel) View() string {
 	// Title
-	title := styles.Bold.Render("PAUSED")
+	title := styles.Current().Bold.Render("PAUSED")
 
 	// Time remaining
 	timer := components.FormatTimer(m.session.TimeLeft)
 
 	// Hints
 	hints := components.RenderHintsResponsive([]components.Hint{
-		{Key: "Esc", Action: "Quit"},
-		{Key: "P", Action: "Resume"},
+		components.KeyHint("Quit", keys.Quit),
+		components.KeyHint("Resume", keys.Pause),
 	}, m.width)
 
 	// Main content (without hints)
@@ -10,6 +10,7 @@ import (
 	"github.com/gurselcakar/arithmego/internal/modes"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -18,15 +19,13 @@ type ModeSelectedMsg struct {
 	Mode *modes.Mode
 }
 
-// Category ordering for display
-var categoryOrder = []string{"Basics", "Powers", "Advanced", "Mixed"}
-
-// categoryModes maps category names to mode IDs in display order
-var categoryModes = map[string][]string{
-	"Basics":   {modes.IDAddition, modes.IDSubtraction, modes.IDMultiplication, modes.IDDivision},
-	"Powers":   {modes.IDSquares, modes.IDCubes, modes.IDSquareRoots, modes.IDCubeRoots},
-	"Advanced": {modes.IDExponents, modes.IDRemainders, modes.IDPercentages, modes.IDFactorials},
-	"Mixed":    {modes.IDMixedBasics, modes.IDMixedPowers, modes.IDMixedAdvanced, modes.IDAnythingGoes},
+// groupModeIDs returns the IDs of the modes in a group, in display order.
+func groupModeIDs(group string) []string {
+	var ids []string
+	for _, mode := range modes.ByGroup(group) {
+		ids = append(ids, mode.ID)
+	}
+	return ids
 }
 
 // PlayBrowseModel represents the Mode Browser screen (Step 1 of play flow).
@@ -90,23 +89,23 @@ func (m PlayBrowseModel) Update(msg tea.Msg) (PlayBrowseModel, tea.Cmd) {
 
 // updateNavigation handles navigation input.
 func (m PlayBrowseModel) updateNavigation(msg tea.KeyMsg) (PlayBrowseModel, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		return m, func() tea.Msg { return ReturnToMenuMsg{} }
 
-	case "up", "k":
+	case keys.Matches(msg, keys.Up):
 		m.moveCursor(-1)
 		m.scrollToSelection()
 		m.updateViewportContent()
 		return m, nil
 
-	case "down", "j":
+	case keys.Matches(msg, keys.Down):
 		m.moveCursor(1)
 		m.scrollToSelection()
 		m.updateViewportContent()
 		return m, nil
 
-	case "enter", "right", "l":
+	case keys.Matches(msg, keys.Select, keys.Right):
 		mode := m.selectedMode()
 		if mode != nil {
 			return m, func() tea.Msg { return ModeSelectedMsg{Mode: mode} }
@@ -161,8 +160,8 @@ func (m *PlayBrowseModel) scrollToSelection() {
 	// Find the line number of the selected mode
 	lineNum := 0
 
-	for _, catName := range categoryOrder {
-		modeIDs := categoryModes[catName]
+	for _, catName := range modes.GroupOrder {
+		modeIDs := groupModeIDs(catName)
 		lineNum += 2 // Category header + blank line
 
 		for _, modeID := range modeIDs {
@@ -204,9 +203,9 @@ func (m PlayBrowseModel) View() string {
 // getHints returns the context-aware hints.
 func (m PlayBrowseModel) getHints() string {
 	return components.RenderHintsResponsive([]components.Hint{
-		{Key: "Esc", Action: "Back"},
-		{Key: "↑↓", Action: "Navigate"},
-		{Key: "→", Action: "Select"},
+		components.KeyHint("Back", keys.Back),
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Select", keys.Right),
 	}, m.width)
 }
 
@@ -261,7 +260,7 @@ func (m PlayBrowseModel) renderContent() string {
 	var lines []string
 
 	// Title
-	title := lipgloss.Place(m.width, 1, lipgloss.Center, lipgloss.Center, styles.Logo.Render("PLAY"))
+	title := lipgloss.Place(m.width, 1, lipgloss.Center, lipgloss.Center, styles.Current().Logo.Render("PLAY"))
 	lines = append(lines, title)
 	lines = append(lines, "")
 
@@ -299,12 +298,12 @@ func (m PlayBrowseModel) renderCategorizedModes() []string {
 	}
 	padding := strings.Repeat(" ", leftPadding)
 
-	for _, catName := range categoryOrder {
-		modeIDs := categoryModes[catName]
+	for _, catName := range modes.GroupOrder {
+		modeIDs := groupModeIDs(catName)
 
 		// Category header
 		separatorWidth := 44 - len(catName)
-		header := styles.Dim.Render("── " + catName + " " + strings.Repeat("─", separatorWidth))
+		header := styles.Current().Dim.Render("── " + catName + " " + strings.Repeat("─", separatorWidth))
 		lines = append(lines, padding+header)
 		lines = append(lines, "")
 
@@ -329,7 +328,7 @@ func (m PlayBrowseModel) renderModeLine(mode *modes.Mode, selected bool, maxName
 	// Focus indicator
 	var prefix string
 	if selected {
-		prefix = styles.Accent.Render("> ")
+		prefix = styles.Current().Accent.Render("> ")
 	} else {
 		prefix = "  "
 	}
@@ -342,7 +341,7 @@ func (m PlayBrowseModel) renderModeLine(mode *modes.Mode, selected bool, maxName
 	desc := mode.Description
 
 	if selected {
-		return prefix + styles.Bold.Render(namePadded) + "    " + styles.Subtle.Render(desc)
+		return prefix + styles.Current().Bold.Render(namePadded) + "    " + styles.Current().Subtle.Render(desc)
 	}
-	return prefix + styles.Subtle.Render(namePadded) + "    " + styles.Dim.Render(desc)
+	return prefix + styles.Current().Subtle.Render(namePadded) + "    " + styles.Current().Dim.Render(desc)
 }
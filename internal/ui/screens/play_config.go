@@ -14,6 +14,7 @@ import (
 	"github.com/gurselcakar/arithmego/internal/modes"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -122,7 +123,7 @@ func (m *PlayConfigModel) generateSampleQuestion() {
 		m.sampleQuestion = ""
 		return
 	}
-	m.sampleQuestion = q.Display
+	m.sampleQuestion = components.QuestionText(*q)
 }
 
 // Init initializes the PlayConfigModel.
@@ -138,32 +139,32 @@ func (m PlayConfigModel) Update(msg tea.Msg) (PlayConfigModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc":
+		switch {
+		case keys.Matches(msg, keys.Back):
 			// Go back to browse
 			return m, func() tea.Msg { return BackToBrowseMsg{} }
 
-		case "up", "k":
+		case keys.Matches(msg, keys.Up):
 			m.focusPrev()
 			m.updateViewportContent()
 			return m, nil
 
-		case "down", "j":
+		case keys.Matches(msg, keys.Down):
 			m.focusNext()
 			m.updateViewportContent()
 			return m, nil
 
-		case "left", "h":
+		case keys.Matches(msg, keys.Left):
 			m.adjustValue(-1)
 			m.updateViewportContent()
 			return m, nil
 
-		case "right", "l":
+		case keys.Matches(msg, keys.Right):
 			m.adjustValue(1)
 			m.updateViewportContent()
 			return m, nil
 
-		case "enter":
+		case keys.Matches(msg, keys.Select):
 			return m, m.startGame()
 		}
 	}
@@ -224,15 +225,27 @@ func (m *PlayConfigModel) adjustValue(delta int) {
 
 // startGame creates the StartGameMsg with current settings.
 func (m PlayConfigModel) startGame() tea.Cmd {
-	if m.selectedMode == nil {
+	msg, ok := m.Selection()
+	if !ok {
 		return nil
 	}
+	return func() tea.Msg {
+		return msg
+	}
+}
+
+// Selection returns the current settings as a StartGameMsg.
+// Returns false if no mode is selected.
+func (m PlayConfigModel) Selection() (StartGameMsg, bool) {
+	if m.selectedMode == nil {
+		return StartGameMsg{}, false
+	}
 
 	diffs := game.AllDifficulties()
 	durs := modes.AllowedDurations
 
 	if len(diffs) == 0 || len(durs) == 0 {
-		return nil
+		return StartGameMsg{}, false
 	}
 
 	diffIndex := m.difficultyIndex
@@ -250,12 +263,38 @@ func (m PlayConfigModel) startGame() tea.Cmd {
 		inputMethod = components.InputMultipleChoice
 	}
 
-	return func() tea.Msg {
-		return StartGameMsg{
-			Mode:        m.selectedMode,
-			Difficulty:  diffs[diffIndex],
-			Duration:    durs[durIndex].Value,
-			InputMethod: inputMethod,
+	return StartGameMsg{
+		Mode:        m.selectedMode,
+		Difficulty:  diffs[diffIndex],
+		Duration:    durs[durIndex].Value,
+		InputMethod: inputMethod,
+	}, true
+}
+
+// PlayOverrides are settings chosen ahead of the config screen, e.g. by
+// command-line flags. Zero values keep the saved or default choice.
+type PlayOverrides struct {
+	Difficulty *game.Difficulty
+	// Duration is ignored by ApplyOverrides unless it is one of
+	// modes.AllowedDurations; a game started straight away (--start) uses
+	// it as given.
+	Duration    time.Duration
+	InputMethod *components.InputMethod
+}
+
+// ApplyOverrides selects the settings set in o.
+func (m *PlayConfigModel) ApplyOverrides(o PlayOverrides) {
+	if o.Difficulty != nil {
+		m.difficultyIndex = findDifficultyIndex(o.Difficulty.String())
+		m.generateSampleQuestion()
+	}
+	if o.Duration != 0 && modes.IsAllowedDuration(o.Duration) {
+		m.durationIndex = modes.FindDurationIndex(o.Duration)
+	}
+	if o.InputMethod != nil {
+		m.inputMethodIndex = 0
+		if *o.InputMethod == components.InputMultipleChoice {
+			m.inputMethodIndex = 1
 		}
 	}
 }
@@ -277,10 +316,10 @@ func (m PlayConfigModel) View() string {
 // getHints returns the hints for the config screen.
 func (m PlayConfigModel) getHints() string {
 	return components.RenderHintsResponsive([]components.Hint{
-		{Key: "Esc", Action: "Back"},
-		{Key: "↑↓", Action: "Navigate"},
-		{Key: "←→", Action: "Change"},
-		{Key: "Enter", Action: "Start"},
+		components.KeyHint("Back", keys.Back),
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Change", keys.Left, keys.Right),
+		components.KeyHint("Start", keys.Select),
 	}, m.width)
 }
 
@@ -322,10 +361,10 @@ func (m PlayConfigModel) renderContent() string {
 	}
 
 	// Title (mode name) - prominent uppercase
-	title := styles.Logo.Render(strings.ToUpper(m.selectedMode.Name))
+	title := styles.Current().Logo.Render(strings.ToUpper(m.selectedMode.Name))
 
 	// Description
-	desc := styles.Dim.Render(m.selectedMode.Description)
+	desc := styles.Current().Dim.Render(m.selectedMode.Description)
 
 	// Settings
 	diffs := game.AllDifficulties()
@@ -345,7 +384,7 @@ func (m PlayConfigModel) renderContent() string {
 	// Focus prefix helper
 	focusPrefix := func(focused bool) string {
 		if focused {
-			return styles.Accent.Render("> ")
+			return styles.Current().Accent.Render("> ")
 		}
 		return "  "
 	}
@@ -388,11 +427,11 @@ func (m PlayConfigModel) renderContent() string {
 	previewBoxWidth := min(m.width-4, 30)
 	previewBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("8")).
+		BorderForeground(styles.Current().Border).
 		Width(previewBoxWidth).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Render(styles.Bold.Render(m.sampleQuestion))
+		Render(styles.Current().Bold.Render(m.sampleQuestion))
 
 	// Build content with better spacing
 	content := lipgloss.JoinVertical(lipgloss.Center,
@@ -10,6 +10,7 @@ import (
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/game/gen"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -205,49 +206,49 @@ func (m PracticeModel) Update(msg tea.Msg) (PracticeModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "esc":
+		switch {
+		case keys.Matches(msg, keys.Quit):
 			return m, func() tea.Msg { return ReturnToMenuMsg{} }
 
-		case "c":
+		case keys.Matches(msg, keys.Category):
 			// Cycle category
 			m.cycleCategory()
 			m.applySelectedOperation()
 			return m, nil
 
-		case "o":
+		case keys.Matches(msg, keys.Operation):
 			// Cycle operation within category
 			m.cycleOperation()
 			m.applySelectedOperation()
 			return m, nil
 
-		case "d":
+		case keys.Matches(msg, keys.Difficulty):
 			// Cycle difficulty
 			m.cycleDifficulty()
 			m.generateQuestion()
 			return m, nil
 
-		case "up", "k":
+		case keys.Matches(msg, keys.Harder):
 			m.adjustDifficulty(1)
 			m.generateQuestion()
 			return m, nil
 
-		case "down", "j":
+		case keys.Matches(msg, keys.Easier):
 			m.adjustDifficulty(-1)
 			m.generateQuestion()
 			return m, nil
 
-		case "m":
+		case keys.Matches(msg, keys.InputMethod):
 			// Toggle input method
 			m.toggleInputMethod()
 			m.generateQuestion()
 			return m, nil
 
-		case "s", " ":
+		case keys.Matches(msg, keys.Skip):
 			m.skip()
 			return m, nil
 
-		case "enter":
+		case keys.Matches(msg, keys.Submit):
 			if m.inputMethod == components.InputTyping {
 				return m.submitAnswer()
 			}
@@ -427,15 +428,15 @@ func (m PracticeModel) viewPractice() string {
 
 	// Clean header without shortcuts
 	header := fmt.Sprintf("%s • %s • %s • %s", catName, opName, m.difficulty.String(), inputMethodName)
-	headerStyled := styles.Subtle.Render(header)
+	headerStyled := styles.Current().Subtle.Render(header)
 
 	// Question (center)
 	var questionView string
 	if m.current != nil {
 		if m.inputMethod == components.InputMultipleChoice {
-			questionView = components.RenderQuestionWithAnswer(m.current.Display)
+			questionView = components.RenderQuestionWithAnswer(components.QuestionText(*m.current))
 		} else {
-			questionView = components.RenderQuestion(m.current.Display)
+			questionView = components.RenderQuestion(components.QuestionText(*m.current))
 		}
 	}
 
@@ -446,7 +447,10 @@ func (m PracticeModel) viewPractice() string {
 	} else {
 		inputContent = m.input.View()
 		if m.showError {
-			inputContent = styles.Incorrect.Render(inputContent)
+			inputContent = styles.Current().Incorrect.Render(inputContent)
+			if styles.Accessible() {
+				inputContent = lipgloss.JoinVertical(lipgloss.Center, inputContent, "Wrong, try again")
+			}
 		}
 	}
 	// Use screen width to prevent layout shift when switching input methods
@@ -474,27 +478,28 @@ func (m PracticeModel) viewPractice() string {
 	var hints string
 	if m.inputMethod == components.InputMultipleChoice {
 		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "C", Action: "Category"},
-			{Key: "O", Action: "Operation"},
-			{Key: "D", Action: "Difficulty"},
-			{Key: "M", Action: "Input"},
-			{Key: "1-4", Action: "Answer"},
-			{Key: "S", Action: "Skip"},
-			{Key: "Q", Action: "Quit"},
+			components.KeyHint("Category", keys.Category),
+			components.KeyHint("Operation", keys.Operation),
+			components.KeyHint("Difficulty", keys.Difficulty),
+			components.KeyHint("Input", keys.InputMethod),
+			components.KeyHint("Answer", keys.Choices...),
+			components.KeyHint("Skip", keys.Skip),
+			components.KeyHint("Quit", keys.Quit),
 		}, hintsWidth)
 	} else {
 		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "C", Action: "Category"},
-			{Key: "O", Action: "Operation"},
-			{Key: "D", Action: "Difficulty"},
-			{Key: "M", Action: "Input"},
-			{Key: "S", Action: "Skip"},
-			{Key: "Q", Action: "Quit"},
+			components.KeyHint("Category", keys.Category),
+			components.KeyHint("Operation", keys.Operation),
+			components.KeyHint("Difficulty", keys.Difficulty),
+			components.KeyHint("Input", keys.InputMethod),
+			components.KeyHint("Skip", keys.Skip),
+			components.KeyHint("Quit", keys.Quit),
 		}, hintsWidth)
 	}
 
-	// Layout with header at top, content centered, hints at bottom
-	if m.width > 0 && m.height > 0 {
+	// Layout with header at top, content centered, hints at bottom.
+	// Accessible mode keeps the plain top-to-bottom layout.
+	if m.width > 0 && m.height > 0 && !styles.Accessible() {
 		hintsHeight := lipgloss.Height(hints)
 		headerHeight := 2 // header + padding
 		bottomPadding := 1
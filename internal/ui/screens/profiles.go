@@ -0,0 +1,139 @@
+package screens
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// ProfileSwitchModel lets the player pick which profile to play as.
+type ProfileSwitchModel struct {
+	profiles []string
+	active   string
+	cursor   int
+	err      error
+	width    int
+	height   int
+}
+
+// ProfileSelectedMsg is sent when a profile is chosen.
+type ProfileSelectedMsg struct {
+	Name string
+}
+
+// NewProfileSwitch creates a profile switcher with the cursor on the active profile.
+func NewProfileSwitch() ProfileSwitchModel {
+	profiles, err := storage.ListProfiles()
+	active := storage.ActiveProfile()
+
+	m := ProfileSwitchModel{
+		profiles: profiles,
+		active:   active,
+		err:      err,
+	}
+	for i, name := range profiles {
+		if name == active {
+			m.cursor = i
+		}
+	}
+	return m
+}
+
+// Init initializes the profile switcher.
+func (m ProfileSwitchModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles profile switcher input.
+func (m ProfileSwitchModel) Update(msg tea.Msg) (ProfileSwitchModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.SetSize(msg.Width, msg.Height)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case keys.Matches(msg, keys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case keys.Matches(msg, keys.Down):
+			if m.cursor < len(m.profiles)-1 {
+				m.cursor++
+			}
+		case keys.Matches(msg, keys.Select, keys.Right):
+			if m.cursor < len(m.profiles) {
+				name := m.profiles[m.cursor]
+				return m, func() tea.Msg {
+					return ProfileSelectedMsg{Name: name}
+				}
+			}
+		case keys.Matches(msg, keys.Back, keys.Left):
+			return m, func() tea.Msg { return ReturnToMenuMsg{} }
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the profile switcher.
+func (m ProfileSwitchModel) View() string {
+	title := styles.Current().Bold.Render("PROFILE")
+
+	var body string
+	if m.err != nil {
+		body = styles.Current().Incorrect.Render("Could not list profiles: " + m.err.Error())
+	} else {
+		lines := make([]string, 0, len(m.profiles))
+		for i, name := range m.profiles {
+			label := name
+			if name == m.active {
+				label += " (current)"
+			}
+			if i == m.cursor {
+				lines = append(lines, styles.Current().Accent.Render("> ")+styles.Current().Selected.Render(label))
+			} else {
+				lines = append(lines, "  "+styles.Current().Unselected.Render(label))
+			}
+		}
+		body = strings.Join(lines, "\n")
+	}
+
+	hint := styles.Current().Dim.Render("Create profiles with 'arithmego profile create <name>'")
+
+	hints := components.RenderHintsResponsive([]components.Hint{
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Switch", keys.Select),
+		components.KeyHint("Back", keys.Back),
+	}, m.width)
+
+	mainContent := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		"",
+		body,
+		"",
+		"",
+		hint,
+	)
+
+	if m.width > 0 && m.height > 0 {
+		availableHeight := m.height - components.HintsHeight
+		centeredMain := lipgloss.Place(m.width, availableHeight, lipgloss.Center, lipgloss.Center, mainContent)
+		centeredHints := lipgloss.Place(m.width, components.HintsHeight, lipgloss.Center, lipgloss.Center, hints)
+		return lipgloss.JoinVertical(lipgloss.Left, centeredMain, centeredHints)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, mainContent, "", "", hints)
+}
+
+// SetSize sets the screen dimensions.
+func (m *ProfileSwitchModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
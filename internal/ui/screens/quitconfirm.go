@@ -9,6 +9,7 @@ import (
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -67,38 +68,38 @@ func (m QuitConfirmModel) Update(msg tea.Msg) (QuitConfirmModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "y":
+		switch {
+		case keys.Matches(msg, keys.Yes):
 			// Quick key for Yes
 			return m, func() tea.Msg {
 				return QuitConfirmAcceptMsg{DontAskAgain: m.dontAskAgain}
 			}
-		case "n", "esc":
+		case keys.Matches(msg, keys.No, keys.Back):
 			// Quick key for No / Cancel
 			return m, func() tea.Msg {
 				return QuitConfirmCancelMsg{Session: m.session, Source: m.source}
 			}
-		case "up", "k":
+		case keys.Matches(msg, keys.Up):
 			// Move to buttons row
 			if m.focusedRow > 0 {
 				m.focusedRow--
 			}
-		case "down", "j":
+		case keys.Matches(msg, keys.Down):
 			// Move to checkbox row
 			if m.focusedRow < 1 {
 				m.focusedRow++
 			}
-		case "left", "h":
+		case keys.Matches(msg, keys.Left):
 			if m.focusedRow == 0 {
 				// On buttons row: select Yes
 				m.selectedYes = true
 			}
-		case "right", "l":
+		case keys.Matches(msg, keys.Right):
 			if m.focusedRow == 0 {
 				// On buttons row: select No
 				m.selectedYes = false
 			}
-		case " ", "enter":
+		case keys.Matches(msg, keys.Toggle, keys.Select):
 			if m.focusedRow == 1 {
 				// On checkbox row: toggle checkbox
 				m.dontAskAgain = !m.dontAskAgain
@@ -124,30 +125,30 @@ func (m QuitConfirmModel) View() string {
 	var b strings.Builder
 
 	// Title
-	title := styles.Bold.Render("QUIT GAME?")
+	title := styles.Current().Bold.Render("QUIT GAME?")
 
 	// Warning message
-	warning := styles.Subtle.Render("Your progress will not be saved.")
+	warning := styles.Current().Subtle.Render("Your progress will not be saved.")
 
 	// Yes/No buttons
 	var yesBtn, noBtn string
 	if m.focusedRow == 0 {
 		// Buttons row is focused
 		if m.selectedYes {
-			yesBtn = styles.Selected.Render("[ Yes ]")
-			noBtn = styles.Unselected.Render("  No  ")
+			yesBtn = styles.Current().Selected.Render("[ Yes ]")
+			noBtn = styles.Current().Unselected.Render("  No  ")
 		} else {
-			yesBtn = styles.Unselected.Render("  Yes  ")
-			noBtn = styles.Selected.Render("[ No ]")
+			yesBtn = styles.Current().Unselected.Render("  Yes  ")
+			noBtn = styles.Current().Selected.Render("[ No ]")
 		}
 	} else {
 		// Buttons row not focused - show current selection dimmed
 		if m.selectedYes {
-			yesBtn = styles.Subtle.Render("[ Yes ]")
-			noBtn = styles.Dim.Render("  No  ")
+			yesBtn = styles.Current().Subtle.Render("[ Yes ]")
+			noBtn = styles.Current().Dim.Render("  No  ")
 		} else {
-			yesBtn = styles.Dim.Render("  Yes  ")
-			noBtn = styles.Subtle.Render("[ No ]")
+			yesBtn = styles.Current().Dim.Render("  Yes  ")
+			noBtn = styles.Current().Subtle.Render("[ No ]")
 		}
 	}
 	buttons := lipgloss.JoinHorizontal(lipgloss.Center, yesBtn, "    ", noBtn)
@@ -157,24 +158,24 @@ func (m QuitConfirmModel) View() string {
 	if m.focusedRow == 1 {
 		// Checkbox row is focused
 		if m.dontAskAgain {
-			checkbox = styles.Selected.Render("[x] Don't ask again")
+			checkbox = styles.Current().Selected.Render("[x] Don't ask again")
 		} else {
-			checkbox = styles.Selected.Render("[ ] Don't ask again")
+			checkbox = styles.Current().Selected.Render("[ ] Don't ask again")
 		}
 	} else {
 		// Checkbox row not focused
 		if m.dontAskAgain {
-			checkbox = styles.Accent.Render("[x] Don't ask again")
+			checkbox = styles.Current().Accent.Render("[x] Don't ask again")
 		} else {
-			checkbox = styles.Subtle.Render("[ ] Don't ask again")
+			checkbox = styles.Current().Subtle.Render("[ ] Don't ask again")
 		}
 	}
 
 	// Hints
 	hints := components.RenderHintsResponsive([]components.Hint{
-		{Key: "Y/N", Action: "Quick Select"},
-		{Key: "↑↓", Action: "Navigate"},
-		{Key: "Enter", Action: "Confirm"},
+		components.KeyHint("Quick Select", keys.Yes, keys.No),
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Confirm", keys.Select),
 	}, m.width)
 
 	// Build main content (without hints)
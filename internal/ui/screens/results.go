@@ -3,12 +3,14 @@ package screens
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/game"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -17,10 +19,27 @@ type ResultsModel struct {
 	session     *game.Session
 	saveError   error
 	isFirstGame bool
+	ghost       *game.Ghost // Best earlier run of the same setup (nil if none)
 	width       int
 	height      int
+
+	// Question table scrolling
+	tableOffset int
 }
 
+// Question table layout
+const (
+	// resultsTableMinRows is the fewest question rows shown when space is short.
+	resultsTableMinRows = 3
+	// resultsQuestionWidth is the width of the question column.
+	resultsQuestionWidth = 18
+	// resultsCompactQuestionWidth is the width of the question column in
+	// the compact layout.
+	resultsCompactQuestionWidth = 12
+	// slowFactor flags answers that took this many times the average response time.
+	slowFactor = 2
+)
+
 // NewResults creates a new results model.
 func NewResults(session *game.Session, saveError error) ResultsModel {
 	return ResultsModel{
@@ -62,25 +81,45 @@ func (m ResultsModel) Update(msg tea.Msg) (ResultsModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		switch {
+		case keys.Matches(msg, keys.Up):
+			m.scrollTable(-1)
+			return m, nil
+		case keys.Matches(msg, keys.Down):
+			m.scrollTable(1)
+			return m, nil
+		case keys.Matches(msg, keys.PageUp):
+			m.scrollTable(-m.tableRows())
+			return m, nil
+		case keys.Matches(msg, keys.PageDown):
+			m.scrollTable(m.tableRows())
+			return m, nil
+		}
+
 		if m.isFirstGame {
 			// First game: only continue to feature tour
-			switch msg.String() {
-			case "enter", "right", "l":
+			if keys.Matches(msg, keys.Select, keys.Right) {
 				return m, func() tea.Msg {
 					return ContinueToFeatureTourMsg{}
 				}
 			}
 		} else {
 			// Normal game: play again or menu
-			switch msg.String() {
-			case "enter":
+			switch {
+			case keys.Matches(msg, keys.Select):
 				return m, func() tea.Msg {
 					return PlayAgainMsg{}
 				}
-			case "m", "esc":
+			case keys.Matches(msg, keys.Menu, keys.Back):
 				return m, func() tea.Msg {
 					return ReturnToMenuMsg{}
 				}
+			case keys.Matches(msg, keys.Mistakes):
+				if len(m.session.Mistakes()) > 0 {
+					return m, func() tea.Msg {
+						return RetryMistakesMsg{}
+					}
+				}
 			}
 		}
 	}
@@ -88,19 +127,17 @@ func (m ResultsModel) Update(msg tea.Msg) (ResultsModel, tea.Cmd) {
 	return m, nil
 }
 
-// View renders the results screen.
-func (m ResultsModel) View() string {
-	var b strings.Builder
-
+// renderSummary renders the totals above the question table.
+func (m ResultsModel) renderSummary() string {
 	// Title
-	title := styles.Bold.Render("RESULTS")
+	title := styles.Current().Bold.Render("RESULTS")
 
 	// Score (prominent)
 	score := components.RenderScore(m.session.Score)
-	scoreLabel := styles.Dim.Render("points")
+	scoreLabel := styles.Current().Dim.Render("points")
 
 	// Separator
-	separator := styles.Dim.Render("─────────────────────")
+	separator := styles.Current().Dim.Render("─────────────────────")
 
 	// Stats line 1: correct count and accuracy
 	correct := fmt.Sprintf("%d/%d correct", m.session.Correct, m.session.TotalAnswered())
@@ -132,35 +169,31 @@ func (m ResultsModel) View() string {
 		statLines = append(statLines, fmt.Sprintf("Skipped       %5d", m.session.Skipped))
 	}
 
-	// Intro message for first game (before feature tour)
-	var introMessage string
-	if m.isFirstGame {
-		introMessage = styles.Tagline.Render("There's more to explore.")
+	// Comparison with the best earlier run
+	var ghostLine string
+	if m.ghost != nil {
+		ghostLine = renderGhostResult(m.session.Score, m.ghost.FinalScore())
 	}
 
-	// Hints based on game type
-	var hints string
+	// Intro message for first game (before feature tour)
+	var introMessage string
 	if m.isFirstGame {
-		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "→", Action: "Continue"},
-		}, m.width)
-	} else {
-		hints = components.RenderHintsResponsive([]components.Hint{
-			{Key: "M", Action: "Menu"},
-			{Key: "↵", Action: "Play"},
-		}, m.width)
+		introMessage = styles.Current().Tagline.Render("There's more to explore.")
 	}
 
 	// Save error warning (if any)
 	var saveWarning string
 	if m.saveError != nil {
-		saveWarning = styles.Dim.Render("(Statistics could not be saved)")
+		saveWarning = styles.Current().Dim.Render("(Statistics could not be saved)")
 	}
 
 	// Build main content
 	var contentParts []string
 	contentParts = append(contentParts, title, "", "")
 	contentParts = append(contentParts, score, scoreLabel, "")
+	if ghostLine != "" {
+		contentParts = append(contentParts, ghostLine, "")
+	}
 	contentParts = append(contentParts, separator, "")
 	contentParts = append(contentParts, statsLine1, "")
 
@@ -178,7 +211,93 @@ func (m ResultsModel) View() string {
 		contentParts = append(contentParts, "", separator, "", introMessage)
 	}
 
-	mainContent := lipgloss.JoinVertical(lipgloss.Center, contentParts...)
+	return lipgloss.JoinVertical(lipgloss.Center, contentParts...)
+}
+
+// renderCompactSummary renders the totals in a few lines for small
+// terminals: score, accuracy, and the notable stats that are set.
+func (m ResultsModel) renderCompactSummary() string {
+	title := styles.Current().Bold.Render("RESULTS") + "  " +
+		components.RenderScore(m.session.Score) + styles.Current().Dim.Render(" points")
+	lines := []string{
+		title,
+		fmt.Sprintf("%d/%d correct · %.0f%%", m.session.Correct, m.session.TotalAnswered(), m.session.Accuracy()),
+	}
+
+	var stats []string
+	if m.session.BestStreak > 0 {
+		stats = append(stats, fmt.Sprintf("Streak %d", m.session.BestStreak))
+	}
+	if avg := m.session.AvgResponseTime(); avg > 0 {
+		stats = append(stats, fmt.Sprintf("Avg %.2fs", avg.Seconds()))
+	}
+	if m.session.Skipped > 0 {
+		stats = append(stats, fmt.Sprintf("Skipped %d", m.session.Skipped))
+	}
+	if len(stats) > 0 {
+		lines = append(lines, styles.Current().Dim.Render(strings.Join(stats, " · ")))
+	}
+
+	if m.ghost != nil {
+		lines = append(lines, renderGhostResult(m.session.Score, m.ghost.FinalScore()))
+	}
+	if m.saveError != nil {
+		lines = append(lines, styles.Current().Dim.Render("(Statistics not saved)"))
+	}
+	if m.isFirstGame {
+		lines = append(lines, styles.Current().Tagline.Render("There's more to explore."))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// compact reports whether the screen uses the compact layout.
+func (m ResultsModel) compact() bool {
+	return components.IsCompact(m.width, m.height)
+}
+
+// renderHints renders the key hints for the results screen.
+func (m ResultsModel) renderHints() string {
+	var hintList []components.Hint
+	if len(m.session.History) > m.tableRows() {
+		hintList = append(hintList, components.KeyHint("Scroll", keys.Up, keys.Down))
+	}
+	if m.isFirstGame {
+		hintList = append(hintList, components.KeyHint("Continue", keys.Right))
+	} else {
+		hintList = append(hintList, components.KeyHint("Menu", keys.Menu))
+		if len(m.session.Mistakes()) > 0 {
+			hintList = append(hintList, components.KeyHint("Retry mistakes", keys.Mistakes))
+		}
+		hintList = append(hintList, components.KeyHint("Play", keys.Select))
+	}
+
+	if m.compact() {
+		return components.RenderHintsCompact(hintList, m.width)
+	}
+	return components.RenderHintsResponsive(hintList, m.width)
+}
+
+// View renders the results screen.
+func (m ResultsModel) View() string {
+	var b strings.Builder
+
+	hints := m.renderHints()
+
+	if m.compact() {
+		mainContent := m.renderCompactSummary()
+		if table := m.renderQuestionTable(); table != "" {
+			mainContent = lipgloss.JoinVertical(lipgloss.Center, mainContent, "", table)
+		}
+		centeredMain := lipgloss.Place(m.width, m.height-components.CompactHintsHeight, lipgloss.Center, lipgloss.Center, mainContent)
+		return lipgloss.JoinVertical(lipgloss.Left, centeredMain, hints)
+	}
+
+	mainContent := m.renderSummary()
+	// Per-question table, scrolled to fit below the summary
+	if table := m.renderQuestionTable(); table != "" {
+		mainContent = lipgloss.JoinVertical(lipgloss.Center, mainContent, "", table)
+	}
 
 	// Bottom-anchored hints layout with small gap at bottom
 	if m.width > 0 && m.height > 0 {
@@ -198,6 +317,150 @@ func (m ResultsModel) View() string {
 	return b.String()
 }
 
+// tableRows returns how many question rows fit on screen.
+func (m ResultsModel) tableRows() int {
+	total := len(m.session.History)
+	if m.height <= 0 {
+		return total
+	}
+	if m.compact() {
+		// Leave room for the hints line, a blank line, the table header,
+		// and the position line; show at least one row.
+		rows := m.height - lipgloss.Height(m.renderCompactSummary()) - components.CompactHintsHeight - 3
+		return min(max(rows, 1), total)
+	}
+	// Leave room for the hints, a blank line, the table header and rule,
+	// and the position line.
+	rows := m.height - lipgloss.Height(m.renderSummary()) - components.HintsHeight - 5
+	if rows < resultsTableMinRows {
+		rows = resultsTableMinRows
+	}
+	return min(rows, total)
+}
+
+// scrollTable moves the table window by delta rows.
+func (m *ResultsModel) scrollTable(delta int) {
+	maxOffset := len(m.session.History) - m.tableRows()
+	m.tableOffset = max(0, min(m.tableOffset+delta, maxOffset))
+}
+
+// renderQuestionTable renders the visible rows of the per-question table.
+// Wrong answers are red; slow answers have their time in yellow.
+func (m ResultsModel) renderQuestionTable() string {
+	history := m.session.History
+	if len(history) == 0 {
+		return ""
+	}
+
+	compact := m.compact()
+	var lines []string
+	if compact {
+		// No time column or rule, so the table fits 40 columns
+		header := fmt.Sprintf("%3s  %-*s %5s %5s %6s", "#", resultsCompactQuestionWidth, "Question", "You", "Ans", "Points")
+		lines = append(lines, styles.Current().Dim.Render(header))
+	} else {
+		header := fmt.Sprintf("%3s  %-*s %6s %6s %6s %6s", "#", resultsQuestionWidth, "Question", "You", "Answer", "Time", "Points")
+		lines = append(lines, styles.Current().Dim.Render(header), styles.Current().Dim.Render(strings.Repeat("─", lipgloss.Width(header))))
+	}
+
+	slow := slowThreshold(m.session.AvgResponseTime())
+	end := min(m.tableOffset+m.tableRows(), len(history))
+	for i := m.tableOffset; i < end; i++ {
+		if compact {
+			lines = append(lines, renderCompactResultsRow(i+1, history[i]))
+		} else {
+			lines = append(lines, renderResultsRow(i+1, history[i], slow))
+		}
+	}
+
+	if len(history) > end-m.tableOffset {
+		position := fmt.Sprintf("%d–%d of %d", m.tableOffset+1, end, len(history))
+		lines = append(lines, styles.Current().Dim.Render(position))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
+// slowThreshold returns the response time at which an answer counts as slow.
+// Answers within the time bonus window never count as slow.
+func slowThreshold(avg time.Duration) time.Duration {
+	return max(avg*slowFactor, game.InstantThreshold)
+}
+
+// renderResultsRow renders one question of the per-question table.
+func renderResultsRow(index int, h game.QuestionHistory, slow time.Duration) string {
+	question := h.Question
+	if len(question) > resultsQuestionWidth {
+		question = question[:resultsQuestionWidth-3] + "..."
+	}
+
+	answer := fmt.Sprint(h.UserAnswer)
+	responseTime := fmt.Sprintf("%.1fs", h.ResponseTime.Seconds())
+	if h.Skipped {
+		answer = "--"
+	}
+	if h.Skipped && !h.TimedOut {
+		responseTime = "--"
+	}
+
+	// A timed-out question shows how long it ran, always as slow
+	timeCol := fmt.Sprintf("%6s", responseTime)
+	if (!h.Skipped && h.ResponseTime >= slow) || h.TimedOut {
+		timeCol = styles.Current().Slow.Render(timeCol)
+	}
+
+	left := fmt.Sprintf("%3d  %-*s %6s %6d ", index, resultsQuestionWidth, question, answer, h.CorrectAnswer)
+	right := fmt.Sprintf(" %+6d", h.PointsEarned)
+	switch {
+	case h.Skipped:
+		return styles.Current().Dim.Render(left) + timeCol + styles.Current().Dim.Render(right)
+	case !h.Correct:
+		return styles.Current().Incorrect.Render(left) + timeCol + styles.Current().Incorrect.Render(right)
+	default:
+		return left + timeCol + right
+	}
+}
+
+// renderCompactResultsRow renders one question of the compact table,
+// without the response time.
+func renderCompactResultsRow(index int, h game.QuestionHistory) string {
+	question := h.Question
+	if len(question) > resultsCompactQuestionWidth {
+		question = question[:resultsCompactQuestionWidth-3] + "..."
+	}
+	answer := fmt.Sprint(h.UserAnswer)
+	if h.Skipped {
+		answer = "--"
+	}
+
+	row := fmt.Sprintf("%3d  %-*s %5s %5d %+6d", index, resultsCompactQuestionWidth, question, answer, h.CorrectAnswer, h.PointsEarned)
+	switch {
+	case h.Skipped:
+		return styles.Current().Dim.Render(row)
+	case !h.Correct:
+		return styles.Current().Incorrect.Render(row)
+	default:
+		return row
+	}
+}
+
+// renderGhostResult describes the final score against the best earlier run.
+func renderGhostResult(score, best int) string {
+	delta := score - best
+	switch {
+	case delta > 0:
+		return components.RenderGhostDelta(delta) + styles.Current().Dim.Render(" · new best")
+	case delta < 0:
+		return components.RenderGhostDelta(delta) + styles.Current().Dim.Render(fmt.Sprintf(" · best is %d", best))
+	default:
+		return components.RenderGhostDelta(delta) + styles.Current().Dim.Render(" · matched your best")
+	}
+}
+
+// SetGhost sets the best earlier run to compare the score with.
+func (m *ResultsModel) SetGhost(ghost *game.Ghost) {
+	m.ghost = ghost
+}
+
 // SetSize sets the screen dimensions.
 func (m *ResultsModel) SetSize(width, height int) {
 	m.width = width
@@ -0,0 +1,242 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// RetryMistakesMsg is sent when the user wants to drill the missed questions.
+type RetryMistakesMsg struct{}
+
+// ReturnToResultsMsg is sent when the user leaves the mistakes drill.
+type ReturnToResultsMsg struct{}
+
+// RetryModel is an untimed drill over the questions missed in a session.
+// A wrong answer can be retried; a skipped question goes to the back of
+// the queue. The drill ends when every question was answered correctly.
+type RetryModel struct {
+	width  int
+	height int
+
+	queue    []game.Question // Questions still to answer; the first is shown
+	total    int
+	firstTry int             // Questions answered correctly on the first attempt
+	missed   map[string]bool // Questions answered wrong or skipped, by Display
+
+	difficulty  game.Difficulty // for multiple choice distractors
+	inputMethod components.InputMethod
+	input       components.InputModel
+	choices     components.ChoicesModel
+	showError   bool // True when a wrong answer was submitted (typing mode)
+}
+
+// NewRetry creates a drill over the given questions.
+func NewRetry(questions []game.Question, diff game.Difficulty, inputMethod components.InputMethod) RetryModel {
+	m := RetryModel{
+		queue:       append([]game.Question(nil), questions...),
+		total:       len(questions),
+		missed:      make(map[string]bool),
+		difficulty:  diff,
+		inputMethod: inputMethod,
+		input:       components.NewInput(),
+		choices:     components.NewChoices(),
+	}
+	m.resetInput()
+	return m
+}
+
+// Init initializes the drill.
+func (m RetryModel) Init() tea.Cmd {
+	return m.input.Init()
+}
+
+// Done reports whether every question was answered correctly.
+func (m RetryModel) Done() bool {
+	return len(m.queue) == 0
+}
+
+// Update handles drill input.
+func (m RetryModel) Update(msg tea.Msg) (RetryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case components.ChoiceSelectedMsg:
+		if m.Done() {
+			return m, nil
+		}
+		if m.queue[0].CheckAnswer(msg.Value).Correct {
+			m.advance()
+		} else {
+			m.missed[m.queue[0].Display] = true
+			m.choices.SetError(msg.Index)
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.Done() {
+			switch {
+			case keys.Matches(msg, keys.Select, keys.Back):
+				return m, func() tea.Msg { return ReturnToResultsMsg{} }
+			case keys.Matches(msg, keys.Menu):
+				return m, func() tea.Msg { return ReturnToMenuMsg{} }
+			}
+			return m, nil
+		}
+
+		switch {
+		case keys.Matches(msg, keys.Back):
+			return m, func() tea.Msg { return ReturnToResultsMsg{} }
+		case keys.Matches(msg, keys.Skip):
+			m.skip()
+			return m, nil
+		case keys.Matches(msg, keys.Submit):
+			if m.inputMethod == components.InputTyping {
+				return m.submitAnswer()
+			}
+			return m, nil
+		default:
+			if m.inputMethod == components.InputMultipleChoice {
+				var cmd tea.Cmd
+				m.choices, cmd = m.choices.Update(msg)
+				return m, cmd
+			}
+			oldValue := m.input.Value()
+			var cmd tea.Cmd
+			m.input, cmd = m.input.Update(msg)
+			// Clear error state when user modifies input
+			if m.showError && m.input.Value() != oldValue {
+				m.showError = false
+			}
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// submitAnswer checks the typed answer. Wrong answers stay for a retry.
+func (m RetryModel) submitAnswer() (RetryModel, tea.Cmd) {
+	answer, err := strconv.Atoi(m.input.Value())
+	if err != nil {
+		return m, nil
+	}
+
+	if m.queue[0].CheckAnswer(answer).Correct {
+		m.advance()
+	} else {
+		m.missed[m.queue[0].Display] = true
+		m.showError = true
+	}
+	return m, nil
+}
+
+// advance removes the answered question and shows the next one.
+func (m *RetryModel) advance() {
+	if !m.missed[m.queue[0].Display] {
+		m.firstTry++
+	}
+	m.queue = m.queue[1:]
+	m.resetInput()
+}
+
+// skip moves the current question to the back of the queue.
+func (m *RetryModel) skip() {
+	// A skipped question no longer counts as answered on the first try.
+	m.missed[m.queue[0].Display] = true
+	if len(m.queue) > 1 {
+		m.queue = append(m.queue[1:], m.queue[0])
+	}
+	m.resetInput()
+}
+
+// resetInput prepares the input for the question at the front of the queue.
+func (m *RetryModel) resetInput() {
+	m.showError = false
+	m.input.Reset()
+	m.choices.Reset()
+	if m.inputMethod == components.InputMultipleChoice && len(m.queue) > 0 {
+		choices, correctIndex := game.GenerateChoices(m.queue[0].Answer, m.difficulty)
+		m.choices.SetChoices(choices, correctIndex)
+	}
+}
+
+// View renders the drill.
+func (m RetryModel) View() string {
+	var header, centerContent, hints string
+
+	if m.Done() {
+		header = styles.Current().Subtle.Render("Retry mistakes")
+		centerContent = lipgloss.JoinVertical(lipgloss.Center,
+			styles.Current().Correct.Render("All mistakes cleared"),
+			"",
+			styles.Current().Dim.Render(fmt.Sprintf("%d/%d right on the first try", m.firstTry, m.total)),
+		)
+		hints = components.RenderHintsResponsive([]components.Hint{
+			components.KeyHint("Menu", keys.Menu),
+			components.KeyHint("Results", keys.Select),
+		}, m.width)
+	} else {
+		header = styles.Current().Subtle.Render(fmt.Sprintf("Retry mistakes • %d left", len(m.queue)))
+
+		q := m.queue[0]
+		var questionView, inputView string
+		if m.inputMethod == components.InputMultipleChoice {
+			questionView = components.RenderQuestionWithAnswer(components.QuestionText(q))
+			inputView = m.choices.View()
+		} else {
+			questionView = components.RenderQuestion(components.QuestionText(q))
+			inputView = m.input.View()
+			if m.showError {
+				inputView = styles.Current().Incorrect.Render(inputView)
+				if styles.Accessible() {
+					inputView = lipgloss.JoinVertical(lipgloss.Center, inputView, "Wrong, try again")
+				}
+			}
+		}
+		centerContent = lipgloss.JoinVertical(lipgloss.Center, questionView, "", inputView)
+
+		answerHint := components.KeyHint("Answer", keys.Submit)
+		if m.inputMethod == components.InputMultipleChoice {
+			answerHint = components.KeyHint("Answer", keys.Choices...)
+		}
+		hints = components.RenderHintsResponsive([]components.Hint{
+			answerHint,
+			components.KeyHint("Skip", keys.Skip),
+			components.KeyHint("Results", keys.Back),
+		}, m.width)
+	}
+
+	// Accessible mode keeps the plain top-to-bottom layout
+	if m.width > 0 && m.height > 0 && !styles.Accessible() {
+		hintsHeight := lipgloss.Height(hints)
+		headerHeight := 2 // header + padding
+		bottomPadding := 1
+		availableHeight := m.height - hintsHeight - bottomPadding - headerHeight
+
+		centeredHeader := lipgloss.Place(m.width, headerHeight, lipgloss.Center, lipgloss.Top, header)
+		centeredContent := lipgloss.Place(m.width, availableHeight, lipgloss.Center, lipgloss.Center, centerContent)
+		centeredHints := lipgloss.Place(m.width, hintsHeight+bottomPadding, lipgloss.Center, lipgloss.Top, hints)
+
+		return lipgloss.JoinVertical(lipgloss.Left, centeredHeader, centeredContent, centeredHints)
+	}
+
+	// Fallback for unknown dimensions
+	return lipgloss.JoinVertical(lipgloss.Center, header, "", "", centerContent, "", "", hints)
+}
+
+// SetSize sets the screen dimensions.
+func (m *RetryModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
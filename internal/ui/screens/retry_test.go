@@ -0,0 +1,142 @@
+package screens
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+)
+
+func typeAnswer(m RetryModel, answer string) RetryModel {
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(answer)})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return m
+}
+
+func TestRetryDrill(t *testing.T) {
+	questions := []game.Question{
+		{Display: "2 + 2", Answer: 4},
+		{Display: "3 + 3", Answer: 6},
+	}
+	m := NewRetry(questions, game.Easy, components.InputTyping)
+
+	// Wrong answers stay on the question.
+	m = typeAnswer(m, "5")
+	if len(m.queue) != 2 || !m.showError {
+		t.Fatalf("after wrong answer: %d left, showError %v", len(m.queue), m.showError)
+	}
+	m.input.Reset()
+	m = typeAnswer(m, "4")
+
+	// Skipping moves the question to the back.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if m.queue[0].Display != "3 + 3" {
+		t.Fatalf("front of queue = %q, want 3 + 3", m.queue[0].Display)
+	}
+	m = typeAnswer(m, "6")
+
+	if !m.Done() {
+		t.Fatal("drill not done after all questions answered")
+	}
+	if m.firstTry != 0 {
+		t.Errorf("firstTry = %d, want 0", m.firstTry)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter on finished drill returned no command")
+	}
+	if _, ok := cmd().(ReturnToResultsMsg); !ok {
+		t.Errorf("enter on finished drill sent %T, want ReturnToResultsMsg", cmd())
+	}
+
+	// A skipped question stays missed when it comes back after another
+	// question was answered.
+	m = NewRetry(questions, game.Easy, components.InputTyping)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = typeAnswer(m, "5")
+	m.input.Reset()
+	m = typeAnswer(m, "6")
+	m = typeAnswer(m, "4")
+	if !m.Done() || m.firstTry != 0 {
+		t.Errorf("after skip: done %v, firstTry = %d, want 0", m.Done(), m.firstTry)
+	}
+}
+
+func TestRetryDrillSkipCountsAsMissed(t *testing.T) {
+	questions := []game.Question{
+		{Display: "2 + 2", Answer: 4},
+		{Display: "3 + 3", Answer: 6},
+		{Display: "4 + 4", Answer: 8},
+	}
+	m := NewRetry(questions, game.Easy, components.InputTyping)
+
+	// Skip 2 + 2, miss 3 + 3 once, then clear the rest.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = typeAnswer(m, "7")
+	m.input.Reset()
+	m = typeAnswer(m, "6")
+	if m.queue[0].Display != "4 + 4" {
+		t.Fatalf("front of queue = %q, want 4 + 4", m.queue[0].Display)
+	}
+	m = typeAnswer(m, "8")
+	if m.queue[0].Display != "2 + 2" {
+		t.Fatalf("front of queue = %q, want the skipped 2 + 2", m.queue[0].Display)
+	}
+	m = typeAnswer(m, "4")
+
+	if !m.Done() {
+		t.Fatal("drill not done after all questions answered")
+	}
+	// Only 4 + 4 was right on the first try; the skipped question was not.
+	if m.firstTry != 1 {
+		t.Errorf("firstTry = %d, want 1", m.firstTry)
+	}
+}
+
+func TestResultsQuestionTable(t *testing.T) {
+	s := game.NewSession(&listGenerator{}, game.Easy, time.Minute)
+	s.Start()
+	for i := 0; i < 20; i++ {
+		if i%5 == 0 {
+			s.SubmitAnswer(-1)
+		} else {
+			s.SubmitAnswer(s.Current.Answer)
+		}
+	}
+
+	m := NewResults(s, nil)
+	m.SetSize(80, 40)
+	rows := m.tableRows()
+	if rows < resultsTableMinRows || rows >= len(s.History) {
+		t.Fatalf("tableRows() = %d, want a scrolled window of 20", rows)
+	}
+
+	for i := 0; i < 30; i++ {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if want := len(s.History) - rows; m.tableOffset != want {
+		t.Errorf("tableOffset = %d after scrolling to the end, want %d", m.tableOffset, want)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil {
+		t.Fatal("r with mistakes returned no command")
+	}
+	if _, ok := cmd().(RetryMistakesMsg); !ok {
+		t.Errorf("r sent %T, want RetryMistakesMsg", cmd())
+	}
+}
+
+type listGenerator struct{ n int }
+
+func (g *listGenerator) Generate(diff game.Difficulty) *game.Question {
+	g.n++
+	return &game.Question{Key: fmt.Sprint(g.n), Answer: g.n, Display: fmt.Sprintf("%d + 0", g.n)}
+}
+
+func (g *listGenerator) Label() string { return "List" }
@@ -1,14 +1,23 @@
 package screens
 
 import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/expr"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
 	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/settings"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
@@ -19,11 +28,18 @@ const (
 	SettingsFieldDifficulty SettingsField = iota
 	SettingsFieldDuration
 	SettingsFieldInputMethod
+	SettingsFieldQuestionTimer
+	SettingsFieldFlashLength
+	SettingsFieldFlashInterval
+	SettingsFieldTheme
+	SettingsFieldKeymap
+	SettingsFieldNotation
 	SettingsFieldAutoUpdate
 	SettingsFieldSkipQuitConfirm
+	SettingsFieldAccessible
 )
 
-const settingsFieldCount = 5
+const settingsFieldCount = 12
 
 
 // SettingsModel represents the settings screen.
@@ -35,6 +51,12 @@ type SettingsModel struct {
 	difficultyIndex  int
 	durationIndex    int
 	inputMethodIndex int
+	flashLengthIndex int
+	flashSpeedIndex  int
+	themes           []string // Built-in and user theme names
+	themeIndex       int
+	keymapIndex      int
+	notationIndex    int
 	width            int
 	height           int
 	viewport         viewport.Model
@@ -51,15 +73,35 @@ func NewSettings(config *storage.Config) SettingsModel {
 	diffIdx := findDifficultyIndex(config.DefaultDifficulty)
 	durIdx := findDurationIndexByMs(config.DefaultDurationMs)
 	inputIdx := 0
-	if config.InputMethod == "multiple_choice" {
+	if config.InputMethod == settings.InputMultipleChoice {
 		inputIdx = 1
 	}
 
+	// A broken themes directory only hides the user themes
+	themes, _ := styles.Names()
+	themeIdx := 0
+	for i, name := range themes {
+		if name == styles.Current().Name {
+			themeIdx = i
+		}
+	}
+
+	keymapIdx := max(slices.Index(keys.PresetNames(), config.Keymap), 0)
+	notationIdx := max(slices.Index(expr.Notations(), config.Notation), 0)
+	flashLengthIdx := max(slices.Index(flashLengths, config.FlashLength), 0)
+	flashSpeedIdx := max(slices.Index(flashIntervals, time.Duration(config.FlashIntervalMs)*time.Millisecond), 0)
+
 	return SettingsModel{
 		config:           config,
+		keymapIndex:      keymapIdx,
+		notationIndex:    notationIdx,
+		themes:           themes,
+		themeIndex:       themeIdx,
 		difficultyIndex:  diffIdx,
 		durationIndex:    durIdx,
 		inputMethodIndex: inputIdx,
+		flashLengthIndex: flashLengthIdx,
+		flashSpeedIndex:  flashSpeedIdx,
 		focusedField:     SettingsFieldDifficulty,
 		viewport:         viewport.New(0, 0),
 		viewportReady:    false,
@@ -82,28 +124,34 @@ func (m SettingsModel) Update(msg tea.Msg) (SettingsModel, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		switch {
+		case keys.Matches(msg, keys.Up):
 			m.focusPrev()
 			m.updateViewportContent()
-		case "down", "j":
+		case keys.Matches(msg, keys.Down):
 			m.focusNext()
 			m.updateViewportContent()
-		case "left", "h":
+		case keys.Matches(msg, keys.Left):
 			m.adjustValue(-1)
 			m.updateViewportContent()
-		case "right", "l":
+		case keys.Matches(msg, keys.Right):
 			m.adjustValue(1)
 			m.updateViewportContent()
-		case "enter", " ":
-			if m.focusedField == SettingsFieldAutoUpdate {
+		case keys.Matches(msg, keys.Select, keys.Toggle):
+			if m.focusedField == SettingsFieldQuestionTimer {
+				m.toggleQuestionTimer()
+				m.updateViewportContent()
+			} else if m.focusedField == SettingsFieldAutoUpdate {
 				m.toggleAutoUpdate()
 				m.updateViewportContent()
 			} else if m.focusedField == SettingsFieldSkipQuitConfirm {
 				m.toggleSkipQuitConfirm()
 				m.updateViewportContent()
+			} else if m.focusedField == SettingsFieldAccessible {
+				m.toggleAccessible()
+				m.updateViewportContent()
 			}
-		case "esc":
+		case keys.Matches(msg, keys.Back):
 			return m, func() tea.Msg {
 				return ReturnToMenuMsg{}
 			}
@@ -143,8 +191,7 @@ func (m *SettingsModel) adjustValue(delta int) {
 		if m.difficultyIndex >= len(diffs) {
 			m.difficultyIndex = len(diffs) - 1
 		}
-		m.config.DefaultDifficulty = diffs[m.difficultyIndex].String()
-		m.saveConfig()
+		m.setValue(settings.KeyDefaultDifficulty, diffs[m.difficultyIndex].String())
 
 	case SettingsFieldDuration:
 		durs := modes.AllowedDurations
@@ -155,17 +202,58 @@ func (m *SettingsModel) adjustValue(delta int) {
 		if m.durationIndex >= len(durs) {
 			m.durationIndex = len(durs) - 1
 		}
-		m.config.DefaultDurationMs = durs[m.durationIndex].Value.Milliseconds()
-		m.saveConfig()
+		m.setValue(settings.KeyDefaultDurationMs, strconv.FormatInt(durs[m.durationIndex].Value.Milliseconds(), 10))
 
 	case SettingsFieldInputMethod:
 		m.toggleInputMethod()
 
+	case SettingsFieldQuestionTimer:
+		m.toggleQuestionTimer()
+
+	case SettingsFieldFlashLength:
+		m.flashLengthIndex = max(0, min(m.flashLengthIndex+delta, len(flashLengths)-1))
+		m.setValue(settings.KeyFlashLength, strconv.Itoa(flashLengths[m.flashLengthIndex]))
+		m.applyFlashOptions()
+
+	case SettingsFieldFlashInterval:
+		m.flashSpeedIndex = max(0, min(m.flashSpeedIndex+delta, len(flashIntervals)-1))
+		m.setValue(settings.KeyFlashIntervalMs, strconv.FormatInt(flashIntervals[m.flashSpeedIndex].Milliseconds(), 10))
+		m.applyFlashOptions()
+
+	case SettingsFieldTheme:
+		m.themeIndex += delta
+		if m.themeIndex < 0 {
+			m.themeIndex = 0
+		}
+		if m.themeIndex >= len(m.themes) {
+			m.themeIndex = len(m.themes) - 1
+		}
+		m.applyTheme(m.themes[m.themeIndex])
+
+	case SettingsFieldKeymap:
+		presets := keys.PresetNames()
+		next := m.keymapIndex + delta
+		if next < 0 || next >= len(presets) {
+			return
+		}
+		if m.applyKeymap(presets[next]) {
+			m.keymapIndex = next
+		}
+
+	case SettingsFieldNotation:
+		notations := expr.Notations()
+		m.notationIndex = max(0, min(m.notationIndex+delta, len(notations)-1))
+		m.setValue(settings.KeyNotation, notations[m.notationIndex])
+		_ = expr.Apply(m.config.Notation)
+
 	case SettingsFieldAutoUpdate:
 		m.toggleAutoUpdate()
 
 	case SettingsFieldSkipQuitConfirm:
 		m.toggleSkipQuitConfirm()
+
+	case SettingsFieldAccessible:
+		m.toggleAccessible()
 	}
 }
 
@@ -173,23 +261,76 @@ func (m *SettingsModel) adjustValue(delta int) {
 func (m *SettingsModel) toggleInputMethod() {
 	if m.inputMethodIndex == 0 {
 		m.inputMethodIndex = 1
-		m.config.InputMethod = "multiple_choice"
+		m.setValue(settings.KeyInputMethod, settings.InputMultipleChoice)
 	} else {
 		m.inputMethodIndex = 0
-		m.config.InputMethod = "typing"
+		m.setValue(settings.KeyInputMethod, settings.InputTyping)
+	}
+}
+
+// applyFlashOptions makes the next Flash Anzan game use the saved length
+// and speed.
+func (m *SettingsModel) applyFlashOptions() {
+	gen.SetFlashOptions(m.config.FlashLength, time.Duration(m.config.FlashIntervalMs)*time.Millisecond)
+}
+
+// applyTheme switches to the named theme right away, so the settings
+// screen itself previews it, and saves it when it loads.
+func (m *SettingsModel) applyTheme(name string) {
+	t, err := styles.Lookup(name)
+	if err != nil {
+		return
+	}
+	styles.Use(t)
+	m.setValue(settings.KeyTheme, name)
+}
+
+// notationSample is the expression the notation preview shows.
+var notationSample = &expr.BinOp{
+	Op:    expr.OpMul,
+	Left:  &expr.UnaryPrefix{Op: expr.OpSqrt, Operand: &expr.Num{Value: 49}},
+	Right: &expr.Paren{Inner: &expr.BinOp{Op: expr.OpSub, Left: &expr.Pow{Base: &expr.Num{Value: 2}, Exp: &expr.Num{Value: 3}}, Right: &expr.Num{Value: 5}}},
+}
+
+// applyKeymap switches to the named key preset right away. It reports false
+// when the preset conflicts with the key_bindings setting.
+func (m *SettingsModel) applyKeymap(name string) bool {
+	if err := settings.Set(m.config, settings.KeyKeymap, name); err != nil {
+		return false
 	}
 	m.saveConfig()
+	_ = keys.Apply(m.config.Keymap, m.config.KeyBindings)
+	m.viewport.KeyMap = keys.Current().Viewport()
+	return true
+}
+
+// toggleQuestionTimer toggles the per-question countdown for new games.
+func (m *SettingsModel) toggleQuestionTimer() {
+	m.setValue(settings.KeyQuestionTimer, strconv.FormatBool(!m.config.QuestionTimer))
 }
 
 // toggleAutoUpdate toggles the auto-update preference.
 func (m *SettingsModel) toggleAutoUpdate() {
-	m.config.AutoUpdate = !m.config.AutoUpdate
-	m.saveConfig()
+	m.setValue(settings.KeyAutoUpdate, strconv.FormatBool(!m.config.AutoUpdate))
 }
 
 // toggleSkipQuitConfirm toggles the skip quit confirmation preference.
 func (m *SettingsModel) toggleSkipQuitConfirm() {
-	m.config.SkipQuitConfirmation = !m.config.SkipQuitConfirmation
+	m.setValue(settings.KeySkipQuitConfirmation, strconv.FormatBool(!m.config.SkipQuitConfirmation))
+}
+
+// toggleAccessible toggles accessible mode. It takes effect right away.
+func (m *SettingsModel) toggleAccessible() {
+	m.setValue(settings.KeyAccessible, strconv.FormatBool(!m.config.Accessible))
+	styles.SetAccessible(m.config.Accessible)
+}
+
+// setValue stores a value through the same validation as the 'config set'
+// command and saves the config. The screen only offers valid values.
+func (m *SettingsModel) setValue(key, value string) {
+	if err := settings.Set(m.config, key, value); err != nil {
+		return
+	}
 	m.saveConfig()
 }
 
@@ -217,19 +358,20 @@ func (m SettingsModel) View() string {
 
 // getHints returns the context-aware hints for the settings screen.
 func (m SettingsModel) getHints() string {
-	if m.focusedField == SettingsFieldAutoUpdate || m.focusedField == SettingsFieldSkipQuitConfirm {
+	switch m.focusedField {
+	case SettingsFieldQuestionTimer, SettingsFieldAutoUpdate, SettingsFieldSkipQuitConfirm, SettingsFieldAccessible:
 		// Toggle hints
 		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "↑↓", Action: "Navigate"},
-			{Key: "Space", Action: "Toggle"},
-			{Key: "Esc", Action: "Back"},
+			components.KeyHint("Navigate", keys.Up, keys.Down),
+			components.KeyHint("Toggle", keys.Toggle),
+			components.KeyHint("Back", keys.Back),
 		}, m.width)
 	}
 	// Selector hints
 	return components.RenderHintsResponsive([]components.Hint{
-		{Key: "↑↓", Action: "Navigate"},
-		{Key: "←→", Action: "Change"},
-		{Key: "Esc", Action: "Back"},
+		components.KeyHint("Navigate", keys.Up, keys.Down),
+		components.KeyHint("Change", keys.Left, keys.Right),
+		components.KeyHint("Back", keys.Back),
 	}, m.width)
 }
 
@@ -267,7 +409,7 @@ func (m *SettingsModel) updateViewportContent() {
 // renderSettingsContent renders the main settings content for the viewport.
 func (m SettingsModel) renderSettingsContent() string {
 	// Title
-	title := styles.Logo.Render("SETTINGS")
+	title := styles.Current().Logo.Render("SETTINGS")
 
 	// Gather all data
 	diffs := game.AllDifficulties()
@@ -275,13 +417,18 @@ func (m SettingsModel) renderSettingsContent() string {
 	inputOptions := []string{"Typing", "Multiple Choice"}
 
 	// All labels used in settings (for width calculation)
-	labels := []string{"Difficulty", "Duration", "Input", "Auto-update", "Skip quit confirm"}
+	labels := []string{"Difficulty", "Duration", "Input", "Question timer", "Numbers", "Speed", "Theme", "Keys", "Notation", "Auto-update", "Skip quit confirm", "Accessible"}
 
 	// All possible values across all selectors
 	allValues := []string{}
 	allValues = append(allValues, difficultyNames(diffs)...)
 	allValues = append(allValues, durationLabels(durs)...)
 	allValues = append(allValues, inputOptions...)
+	allValues = append(allValues, flashLengthLabels()...)
+	allValues = append(allValues, flashIntervalLabels()...)
+	allValues = append(allValues, m.themes...)
+	allValues = append(allValues, keys.PresetNames()...)
+	allValues = append(allValues, expr.Notations()...)
 
 	// Calculate widths dynamically
 	labelWidth := maxLen(labels)
@@ -290,14 +437,15 @@ func (m SettingsModel) renderSettingsContent() string {
 	// Focus indicator helper
 	focusPrefix := func(focused bool) string {
 		if focused {
-			return styles.Accent.Render("> ")
+			return styles.Current().Accent.Render("> ")
 		}
 		return "  "
 	}
 
 	// Section headers
-	gameDefaultsHeader := styles.Dim.Render("── Game Defaults ──")
-	preferencesHeader := styles.Dim.Render("── Preferences ──")
+	gameDefaultsHeader := styles.Current().Dim.Render("── Game Defaults ──")
+	flashHeader := styles.Current().Dim.Render("── Flash Anzan ──")
+	preferencesHeader := styles.Current().Dim.Render("── Preferences ──")
 
 	// Game defaults rows
 	difficultyRow := focusPrefix(m.focusedField == SettingsFieldDifficulty) +
@@ -324,7 +472,58 @@ func (m SettingsModel) renderSettingsContent() string {
 			Focused:    m.focusedField == SettingsFieldInputMethod,
 		})
 
+	questionTimerRow := focusPrefix(m.focusedField == SettingsFieldQuestionTimer) +
+		components.RenderToggle(m.config.QuestionTimer, components.ToggleOptions{
+			Label:      "Question timer",
+			LabelWidth: labelWidth,
+			Focused:    m.focusedField == SettingsFieldQuestionTimer,
+		})
+
+	// Flash Anzan rows
+	flashLengthRow := focusPrefix(m.focusedField == SettingsFieldFlashLength) +
+		components.RenderSelector(m.flashLengthIndex, flashLengthLabels(), components.SelectorOptions{
+			Label:      "Numbers",
+			LabelWidth: labelWidth,
+			ValueWidth: valueWidth,
+			Focused:    m.focusedField == SettingsFieldFlashLength,
+		})
+
+	flashSpeedRow := focusPrefix(m.focusedField == SettingsFieldFlashInterval) +
+		components.RenderSelector(m.flashSpeedIndex, flashIntervalLabels(), components.SelectorOptions{
+			Label:      "Speed",
+			LabelWidth: labelWidth,
+			ValueWidth: valueWidth,
+			Focused:    m.focusedField == SettingsFieldFlashInterval,
+		})
+
 	// Preferences rows
+	themeRow := focusPrefix(m.focusedField == SettingsFieldTheme) +
+		components.RenderSelector(m.themeIndex, m.themes, components.SelectorOptions{
+			Label:      "Theme",
+			LabelWidth: labelWidth,
+			ValueWidth: valueWidth,
+			Focused:    m.focusedField == SettingsFieldTheme,
+		})
+	keymapRow := focusPrefix(m.focusedField == SettingsFieldKeymap) +
+		components.RenderSelector(m.keymapIndex, keys.PresetNames(), components.SelectorOptions{
+			Label:      "Keys",
+			LabelWidth: labelWidth,
+			ValueWidth: valueWidth,
+			Focused:    m.focusedField == SettingsFieldKeymap,
+		})
+
+	notationRow := focusPrefix(m.focusedField == SettingsFieldNotation) +
+		components.RenderSelector(m.notationIndex, expr.Notations(), components.SelectorOptions{
+			Label:      "Notation",
+			LabelWidth: labelWidth,
+			ValueWidth: valueWidth,
+			Focused:    m.focusedField == SettingsFieldNotation,
+		})
+
+	// Previews aligned under the selector value
+	themePreview := strings.Repeat(" ", 2+labelWidth+2) + renderThemePreview()
+	notationPreview := strings.Repeat(" ", 2+labelWidth+2) + styles.Current().Dim.Render(expr.Render(notationSample, expr.Current()))
+
 	autoUpdateRow := focusPrefix(m.focusedField == SettingsFieldAutoUpdate) +
 		components.RenderToggle(m.config.AutoUpdate, components.ToggleOptions{
 			Label:      "Auto-update",
@@ -339,6 +538,13 @@ func (m SettingsModel) renderSettingsContent() string {
 			Focused:    m.focusedField == SettingsFieldSkipQuitConfirm,
 		})
 
+	accessibleRow := focusPrefix(m.focusedField == SettingsFieldAccessible) +
+		components.RenderToggle(m.config.Accessible, components.ToggleOptions{
+			Label:      "Accessible",
+			LabelWidth: labelWidth,
+			Focused:    m.focusedField == SettingsFieldAccessible,
+		})
+
 	// Build settings block with section headers
 	settingsBlock := lipgloss.JoinVertical(lipgloss.Left,
 		gameDefaultsHeader,
@@ -346,11 +552,23 @@ func (m SettingsModel) renderSettingsContent() string {
 		difficultyRow,
 		durationRow,
 		inputMethodRow,
+		questionTimerRow,
+		"",
+		flashHeader,
+		"",
+		flashLengthRow,
+		flashSpeedRow,
 		"",
 		preferencesHeader,
 		"",
+		themeRow,
+		themePreview,
+		keymapRow,
+		notationRow,
+		notationPreview,
 		autoUpdateRow,
 		skipQuitConfirmRow,
+		accessibleRow,
 	)
 
 	// Build main content with centered title and settings block
@@ -375,6 +593,17 @@ func (m *SettingsModel) Config() *storage.Config {
 
 // Helper functions
 
+// renderThemePreview renders sample text in the active theme's colors.
+func renderThemePreview() string {
+	t := styles.Current()
+	return t.Correct.Render("✓ 42") + " " +
+		t.Incorrect.Render("✗ 17") + " " +
+		t.Slow.Render("slow") + " " +
+		t.Accent.Render("focus") + " " +
+		t.Multiplier.Render("×1.5") + " " +
+		t.Dim.Render("hint")
+}
+
 func findDurationIndexByMs(ms int64) int {
 	for i, d := range modes.AllowedDurations {
 		if d.Value.Milliseconds() == ms {
@@ -391,3 +620,32 @@ func durationLabels(durs []modes.Duration) []string {
 	}
 	return names
 }
+
+// Flash Anzan choices on the settings screen. Zero keeps the difficulty's
+// default.
+var (
+	flashLengths   = []int{0, 3, 5, 8, 10, 15, 20}
+	flashIntervals = []time.Duration{0, 1500 * time.Millisecond, time.Second, 700 * time.Millisecond, 500 * time.Millisecond, 300 * time.Millisecond, 200 * time.Millisecond}
+)
+
+func flashLengthLabels() []string {
+	names := make([]string, len(flashLengths))
+	for i, n := range flashLengths {
+		names[i] = "Auto"
+		if n > 0 {
+			names[i] = strconv.Itoa(n)
+		}
+	}
+	return names
+}
+
+func flashIntervalLabels() []string {
+	names := make([]string, len(flashIntervals))
+	for i, d := range flashIntervals {
+		names[i] = "Auto"
+		if d > 0 {
+			names[i] = d.String()
+		}
+	}
+	return names
+}
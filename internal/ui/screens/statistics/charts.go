@@ -124,14 +124,14 @@ func RenderLineChart(points []analytics.TrendPoint, metric analytics.TrendMetric
 
 	for row := 0; row < height; row++ {
 		label := fmt.Sprintf("%*s │", maxLabelWidth, yLabels[row])
-		b.WriteString(styles.Dim.Render(label))
+		b.WriteString(styles.Current().Dim.Render(label))
 		b.WriteString(string(grid[row]))
 		b.WriteString("\n")
 	}
 
 	// X-axis (align └ with │)
 	axisPrefix := strings.Repeat(" ", maxLabelWidth+1) + "└"
-	b.WriteString(styles.Dim.Render(axisPrefix + strings.Repeat("─", width)))
+	b.WriteString(styles.Current().Dim.Render(axisPrefix + strings.Repeat("─", width)))
 	b.WriteString("\n")
 
 	// X-axis labels (start and end dates)
@@ -143,7 +143,7 @@ func RenderLineChart(points []analytics.TrendPoint, metric analytics.TrendMetric
 		if padding > 0 {
 			labelLine += strings.Repeat(" ", padding) + endDate
 		}
-		b.WriteString(styles.Dim.Render(labelLine))
+		b.WriteString(styles.Current().Dim.Render(labelLine))
 	}
 
 	return b.String()
@@ -179,7 +179,7 @@ func RenderBarChart(data []analytics.WeeklyData, maxWidth int) string {
 	for _, d := range data {
 		// Render label
 		label := fmt.Sprintf("%-8s ", d.Label)
-		b.WriteString(styles.Dim.Render(label))
+		b.WriteString(styles.Current().Dim.Render(label))
 
 		// Render bar (fixed width with padding)
 		filled := d.Sessions * barWidth / maxSessions
@@ -188,7 +188,7 @@ func RenderBarChart(data []analytics.WeeklyData, maxWidth int) string {
 		}
 		empty := barWidth - filled
 		bar := strings.Repeat("█", filled) + strings.Repeat(" ", empty)
-		b.WriteString(styles.Correct.Render(bar))
+		b.WriteString(styles.Current().Correct.Render(bar))
 
 		// Render count (singular/plural, fixed width)
 		sessionWord := "sessions"
@@ -214,9 +214,9 @@ func RenderMiniBarChart(accuracy float64, width int) string {
 
 	// Color based on accuracy
 	if accuracy >= 80 {
-		return styles.Correct.Render(bar)
+		return styles.Current().Correct.Render(bar)
 	} else if accuracy < 60 {
-		return styles.Incorrect.Render(bar)
+		return styles.Current().Incorrect.Render(bar)
 	}
 	return bar
 }
@@ -18,6 +18,7 @@ const (
 	ViewHistory
 	ViewSessionDetail
 	ViewSessionFullLog
+	ViewSessionReplay // Replay of the selected session
 	ViewTrends
 )
 
@@ -163,9 +164,9 @@ func FormatThinkingTime(ms int64) string {
 func FormatAccuracy(accuracy float64) string {
 	text := fmt.Sprintf("%.0f%%", accuracy)
 	if accuracy >= 80 {
-		return styles.Correct.Render(text)
+		return styles.Current().Correct.Render(text)
 	} else if accuracy < 60 {
-		return styles.Incorrect.Render(text)
+		return styles.Current().Incorrect.Render(text)
 	}
 	return text
 }
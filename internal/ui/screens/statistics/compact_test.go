@@ -0,0 +1,68 @@
+package statistics
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+var update = flag.Bool("update", false, "rewrite snapshot files in testdata")
+
+// assertSnapshot compares a rendered view with testdata/<name>.golden,
+// rewriting the file when run with -update.
+func assertSnapshot(t *testing.T, name, view string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(view), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading snapshot (run with -update to create it): %v", err)
+	}
+	if view != string(want) {
+		t.Errorf("view does not match %s:\n%s\nwant:\n%s", path, view, want)
+	}
+}
+
+func TestDashboardSnapshots(t *testing.T) {
+	session := replaySession()
+	session.ID = "snapshot"
+	session.Timestamp = time.Now().Add(-72 * time.Hour)
+	session.Difficulty = "Easy"
+	session.QuestionsAttempted = 3
+	session.QuestionsCorrect = 2
+	session.QuestionsWrong = 1
+	session.Score = 185
+	session.BestStreak = 1
+	session.AvgResponseTimeMs = 1500
+	for i := range session.Questions {
+		session.Questions[i].Operation = "Addition"
+	}
+	stats := &storage.Statistics{Sessions: []storage.SessionRecord{session}}
+
+	for _, size := range [][2]int{{40, 10}, {60, 15}, {80, 24}} {
+		m := New()
+		m.SetSize(size[0], size[1])
+		m, _ = m.Update(statisticsLoadedMsg{stats: stats})
+
+		view := m.View()
+		lines := strings.Split(view, "\n")
+		if len(lines) > size[1] {
+			t.Errorf("%dx%d: view is %d lines", size[0], size[1], len(lines))
+		}
+		assertSnapshot(t, fmt.Sprintf("dashboard_%dx%d", size[0], size[1]), view)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/gurselcakar/arithmego/internal/analytics"
+	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
 	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
@@ -23,7 +24,7 @@ func RenderDashboardContent(agg analytics.ExtendedAggregates, width int) string
 	var sections []string
 
 	// Title
-	sections = append(sections, styles.Bold.Render("STATISTICS"))
+	sections = append(sections, styles.Current().Bold.Render("STATISTICS"))
 	sections = append(sections, "")
 
 	// Stats row: points • sessions • accuracy
@@ -58,7 +59,48 @@ func RenderDashboardContent(agg analytics.ExtendedAggregates, width int) string
 		sections = append(sections, "")
 	}
 	if !agg.LastPlayedAt.IsZero() {
-		sections = append(sections, styles.Dim.Render("Last played: "+FormatRelativeTime(agg.LastPlayedAt)))
+		sections = append(sections, styles.Current().Dim.Render("Last played: "+FormatRelativeTime(agg.LastPlayedAt)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, sections...)
+}
+
+// RenderDashboardCompactContent generates the dashboard for small
+// terminals: totals on short lines and records as a list instead of a grid.
+func RenderDashboardCompactContent(agg analytics.ExtendedAggregates, width int) string {
+	if agg.TotalSessions == 0 {
+		return renderEmptyDashboardContent(width)
+	}
+
+	sections := []string{
+		styles.Current().Bold.Render("STATISTICS"),
+		fmt.Sprintf("%d points  •  %d sessions", agg.TotalPoints, agg.TotalSessions),
+		fmt.Sprintf("%.0f%% accuracy", agg.OverallAccuracy),
+	}
+
+	if opSection := renderOperationsSection(agg); opSection != "" {
+		sections = append(sections, "", opSection)
+	}
+
+	var records []string
+	if agg.PersonalBests.BestStreak > 0 {
+		records = append(records, fmt.Sprintf("Best Streak  %8d", agg.PersonalBests.BestStreak))
+	}
+	if agg.PersonalBests.BestScore > 0 {
+		records = append(records, fmt.Sprintf("High Score   %8d", agg.PersonalBests.BestScore))
+	}
+	if agg.PersonalBests.BestAccuracy > 0 {
+		records = append(records, fmt.Sprintf("Best Acc     %8s", fmt.Sprintf("%.0f%%", agg.PersonalBests.BestAccuracy)))
+	}
+	if agg.FastestResponseMs > 0 {
+		records = append(records, fmt.Sprintf("Fastest      %8s", FormatResponseTime(agg.FastestResponseMs)))
+	}
+	if len(records) > 0 {
+		sections = append(sections, "", styles.Current().Bold.Render("RECORDS"), strings.Join(records, "\n"))
+	}
+
+	if agg.TotalResponseTimeMs > 0 {
+		sections = append(sections, "", FormatThinkingTime(agg.TotalResponseTimeMs)+" thinking")
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Center, sections...)
@@ -66,7 +108,7 @@ func RenderDashboardContent(agg analytics.ExtendedAggregates, width int) string
 
 // renderSeparator renders a horizontal separator line.
 func renderSeparator(width int) string {
-	return styles.Dim.Render(strings.Repeat("━", width))
+	return styles.Current().Dim.Render(strings.Repeat("━", width))
 }
 
 // renderOperationsSection renders the operations with progress bars.
@@ -104,7 +146,7 @@ func renderOperationsSection(agg analytics.ExtendedAggregates) string {
 	})
 
 	var lines []string
-	lines = append(lines, styles.Bold.Render("OPERATIONS"))
+	lines = append(lines, styles.Current().Bold.Render("OPERATIONS"))
 	lines = append(lines, "")
 
 	// Find max operation name length for alignment
@@ -126,9 +168,9 @@ func renderOperationsSection(agg analytics.ExtendedAggregates) string {
 
 		// Color the accuracy
 		if stats.Accuracy >= 80 {
-			accStr = styles.Correct.Render(accStr)
+			accStr = styles.Current().Correct.Render(accStr)
 		} else if stats.Accuracy < 60 {
-			accStr = styles.Incorrect.Render(accStr)
+			accStr = styles.Current().Incorrect.Render(accStr)
 		}
 
 		// Progress bar
@@ -147,7 +189,7 @@ func renderOperationsSection(agg analytics.ExtendedAggregates) string {
 // renderRecordsSection renders the records in a 2x2 grid.
 func renderRecordsSection(agg analytics.ExtendedAggregates) string {
 	var lines []string
-	lines = append(lines, styles.Bold.Render("RECORDS"))
+	lines = append(lines, styles.Current().Bold.Render("RECORDS"))
 	lines = append(lines, "")
 
 	// Build 2x2 grid: Best Streak | High Score
@@ -184,21 +226,40 @@ func renderRecordsSection(agg analytics.ExtendedAggregates) string {
 
 	// If no records yet
 	if len(lines) == 2 {
-		lines = append(lines, styles.Dim.Render("Play more to unlock!"))
+		lines = append(lines, styles.Current().Dim.Render("Play more to unlock!"))
 	}
 
 	return strings.Join(lines, "\n")
 }
 
+// RenderRecoveryNotice renders the notice shown after a damaged statistics
+// file was recovered, summarizing what was restored and where the damaged file went.
+func RenderRecoveryNotice(report storage.RecoveryReport) string {
+	var lines []string
+	lines = append(lines, styles.Current().Incorrect.Render("Your statistics file was damaged and has been repaired."))
+
+	summary := fmt.Sprintf("Recovered %d sessions", report.Total())
+	if report.FromBackup > 0 {
+		summary += fmt.Sprintf(" (%d from the damaged file, %d from backup)", report.Salvaged, report.FromBackup)
+	}
+	lines = append(lines, summary)
+
+	if report.QuarantinePath != "" {
+		lines = append(lines, styles.Current().Dim.Render("Damaged file kept at "+report.QuarantinePath))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
+
 // renderEmptyDashboardContent renders the empty state content for dashboard.
 func renderEmptyDashboardContent(width int) string {
 	content := lipgloss.JoinVertical(lipgloss.Center,
-		styles.Bold.Render("STATISTICS"),
+		styles.Current().Bold.Render("STATISTICS"),
 		"",
 		"",
 		"Play your first game!",
 		"",
-		styles.Dim.Render("Complete a session to see your stats."),
+		styles.Current().Dim.Render("Complete a session to see your stats."),
 	)
 
 	// Center the content
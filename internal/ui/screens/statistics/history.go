@@ -28,7 +28,7 @@ func RenderHistoryContent(
 	var b strings.Builder
 
 	// Title
-	b.WriteString(styles.Bold.Render("STATISTICS · HISTORY"))
+	b.WriteString(styles.Current().Bold.Render("STATISTICS · HISTORY"))
 	b.WriteString("\n\n")
 
 	// Filter status
@@ -37,7 +37,7 @@ func RenderHistoryContent(
 		filterPanel.GetDifficultyDisplay(),
 		filterPanel.GetTimePeriodDisplay(),
 	)
-	b.WriteString(styles.Dim.Render(filterLine))
+	b.WriteString(styles.Current().Dim.Render(filterLine))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -45,15 +45,15 @@ func RenderHistoryContent(
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	// Empty state
 	if len(sessions) == 0 {
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("No sessions found."))
+		b.WriteString(styles.Current().Dim.Render("No sessions found."))
 		b.WriteString("\n\n")
-		b.WriteString(styles.Dim.Render("Try different filters or play more games!"))
+		b.WriteString(styles.Current().Dim.Render("Try different filters or play more games!"))
 		b.WriteString("\n")
 
 		return b.String()
@@ -62,9 +62,9 @@ func RenderHistoryContent(
 	// Column headers
 	headerLine := fmt.Sprintf("     %-35s  %5s  %4s  %6s",
 		"SESSION", "SCORE", "ACC", "STREAK")
-	b.WriteString(styles.Dim.Render(headerLine))
+	b.WriteString(styles.Current().Dim.Render(headerLine))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine))))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine))))
 	b.WriteString("\n")
 
 	// Get visible sessions for current page
@@ -90,7 +90,7 @@ func RenderHistoryContent(
 				b.WriteString("\n")
 			}
 			currentDate = dateStr
-			b.WriteString(styles.Dim.Render(dateStr))
+			b.WriteString(styles.Current().Dim.Render(dateStr))
 			b.WriteString("\n")
 		}
 
@@ -104,7 +104,7 @@ func RenderHistoryContent(
 	if totalPages > 1 {
 		b.WriteString("\n")
 		pageInfo := fmt.Sprintf("Page %d of %d", currentPage+1, totalPages)
-		b.WriteString(lipgloss.Place(separatorWidth, 1, lipgloss.Center, lipgloss.Center, styles.Dim.Render(pageInfo)))
+		b.WriteString(lipgloss.Place(separatorWidth, 1, lipgloss.Center, lipgloss.Center, styles.Current().Dim.Render(pageInfo)))
 	}
 
 	return b.String()
@@ -115,7 +115,7 @@ func renderSessionRow(session storage.SessionRecord, selected bool) string {
 	// Selection indicator
 	prefix := "  "
 	if selected {
-		prefix = styles.Accent.Render("▸ ")
+		prefix = styles.Current().Accent.Render("▸ ")
 	}
 
 	// Format: "▸ Addition (Medium)                248    92%      8"
@@ -138,7 +138,7 @@ func renderSessionRow(session storage.SessionRecord, selected bool) string {
 	)
 
 	if selected {
-		return styles.Bold.Render(line)
+		return styles.Current().Bold.Render(line)
 	}
 	return line
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/gurselcakar/arithmego/internal/analytics"
 	"github.com/gurselcakar/arithmego/internal/storage"
 	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/keys"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
 )
 
 var errLoadFailed = errors.New("failed to load statistics")
@@ -31,6 +33,7 @@ type Model struct {
 
 	// Data
 	stats      *storage.Statistics
+	filtered   *storage.Statistics // Sessions in the filtered period and difficulty
 	aggregates analytics.ExtendedAggregates
 
 	// Current view
@@ -61,9 +64,17 @@ type Model struct {
 	sessionDetailMode SessionDetailMode
 	sessionLogNav     SessionLogNavigation
 
+	// Session replay state
+	replay       ReplayModel
+	replayReturn StatisticsView // view to return to when the replay closes
+	replays      int            // replays opened so far, to tag their ticks
+
 	// Trends view state
 	trendsState TrendsState
 
+	// Recovery report (set when a damaged statistics file was recovered)
+	recovery *storage.RecoveryReport
+
 	// Loading/error state
 	loading bool
 	err     error
@@ -90,8 +101,31 @@ type loadStatisticsMsg struct{}
 
 // statisticsLoadedMsg carries loaded statistics.
 type statisticsLoadedMsg struct {
-	stats *storage.Statistics
-	err   error
+	stats    *storage.Statistics
+	recovery *storage.RecoveryReport
+	err      error
+}
+
+// loadStatistics loads all sessions from the configured storage backend,
+// repairing a damaged store when the backend supports it and otherwise
+// checking that it is intact.
+func loadStatistics() (*storage.Statistics, *storage.RecoveryReport, error) {
+	backend, err := storage.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer backend.Close()
+
+	if r, ok := backend.(storage.Recoverer); ok {
+		return r.LoadWithRecovery()
+	}
+	if c, ok := backend.(storage.Checker); ok {
+		if err := c.Check(); err != nil {
+			return nil, nil, err
+		}
+	}
+	stats, err := backend.Load()
+	return stats, nil, err
 }
 
 // ReturnToMenuMsg signals return to main menu.
@@ -109,14 +143,15 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	case loadStatisticsMsg:
 		m.loading = true
-		stats, err := storage.Load()
+		stats, recovery, err := loadStatistics()
 		return m, func() tea.Msg {
-			return statisticsLoadedMsg{stats: stats, err: err}
+			return statisticsLoadedMsg{stats: stats, recovery: recovery, err: err}
 		}
 
 	case statisticsLoadedMsg:
 		m.loading = false
 		m.stats = msg.stats
+		m.recovery = msg.recovery
 		m.err = msg.err
 
 		if m.stats == nil && m.err == nil {
@@ -124,12 +159,20 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 		if m.stats != nil {
+			m.filtered = m.stats
 			m.aggregates = analytics.ComputeExtendedAggregates(m.stats)
 			m.rebuildLists()
 		}
 		m.updateViewportContent()
 		return m, nil
 
+	case replayTickMsg:
+		if m.view == ViewSessionReplay {
+			m.replay, cmd = m.replay.Update(msg)
+			m.updateViewportContent()
+		}
+		return m, cmd
+
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 	}
@@ -158,6 +201,8 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m.handleSessionDetailKeys(msg)
 	case ViewSessionFullLog:
 		return m.handleSessionLogKeys(msg)
+	case ViewSessionReplay:
+		return m.handleSessionReplayKeys(msg)
 	case ViewTrends:
 		return m.handleTrendsKeys(msg)
 	}
@@ -167,20 +212,20 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleDashboardKeys handles dashboard view keys.
 func (m Model) handleDashboardKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		return m, func() tea.Msg { return ReturnToMenuMsg{} }
-	case "o", "O":
+	case keys.Matches(msg, keys.ShowOperations):
 		m.view = ViewOperations
 		m.operationIndex = 0
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "h", "H":
+	case keys.Matches(msg, keys.ShowHistory):
 		m.view = ViewHistory
 		m.historyNav.Reset(len(m.sessionList))
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "t", "T":
+	case keys.Matches(msg, keys.ShowTrends):
 		m.view = ViewTrends
 		m.updateTrendsData()
 		m.updateViewportContent()
@@ -196,34 +241,34 @@ func (m Model) handleDashboardKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleOperationsKeys handles operations view keys.
 func (m Model) handleOperationsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		m.view = ViewDashboard
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "c", "C":
+	case keys.Matches(msg, keys.Category):
 		m.filterPanel.CycleCategory()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "d", "D":
+	case keys.Matches(msg, keys.Difficulty):
 		m.filterPanel.CycleDifficulty()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "p", "P":
+	case keys.Matches(msg, keys.Period):
 		m.filterPanel.CycleTimePeriod()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "up", "k":
+	case keys.Matches(msg, keys.Up):
 		if m.operationIndex > 0 {
 			m.operationIndex--
 			m.updateViewportContent()
 		}
-	case "down", "j":
+	case keys.Matches(msg, keys.Down):
 		if m.operationIndex < len(m.operationList)-1 {
 			m.operationIndex++
 			m.updateViewportContent()
 		}
-	case "enter":
+	case keys.Matches(msg, keys.Select):
 		if len(m.operationList) > 0 {
 			m.selectedOperation = GetSelectedOperation(m.operationList, m.operationIndex)
 			m.view = ViewOperationDetail
@@ -238,24 +283,24 @@ func (m Model) handleOperationsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleOperationDetailKeys handles operation detail view keys.
 func (m Model) handleOperationDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		m.view = ViewOperations
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "left", "h":
+	case keys.Matches(msg, keys.Left):
 		// Cycle difficulty filter
 		if m.opDetailDifficultyIdx > 0 {
 			m.opDetailDifficultyIdx--
 			m.updateViewportContent()
 		}
-	case "right", "l":
+	case keys.Matches(msg, keys.Right):
 		diffs := analytics.AllDifficulties()
 		if m.opDetailDifficultyIdx < len(diffs)-1 {
 			m.opDetailDifficultyIdx++
 			m.updateViewportContent()
 		}
-	case "r", "R":
+	case keys.Matches(msg, keys.Mistakes):
 		// Enter review all mistakes mode
 		// Load all mistakes (not just 5)
 		m.opReviewMistakes = analytics.GetRecentMistakes(m.stats, m.selectedOperation, 1000)
@@ -275,8 +320,8 @@ func (m Model) handleOperationDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleOperationReviewKeys handles operation review (all mistakes) view keys.
 func (m Model) handleOperationReviewKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		m.view = ViewOperationDetail
 		m.updateViewportContent()
 		m.viewport.GotoTop()
@@ -291,36 +336,36 @@ func (m Model) handleOperationReviewKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleHistoryKeys handles history view keys.
 func (m Model) handleHistoryKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		m.view = ViewDashboard
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "c", "C":
+	case keys.Matches(msg, keys.Category):
 		m.filterPanel.CycleCategory()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "d", "D":
+	case keys.Matches(msg, keys.Difficulty):
 		m.filterPanel.CycleDifficulty()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "p", "P":
+	case keys.Matches(msg, keys.Period):
 		m.filterPanel.CycleTimePeriod()
 		m.applyFilters()
 		m.updateViewportContent()
-	case "up", "k":
+	case keys.Matches(msg, keys.Up):
 		m.historyNav.MoveUp()
 		m.updateViewportContent()
-	case "down", "j":
+	case keys.Matches(msg, keys.Down):
 		m.historyNav.MoveDown()
 		m.updateViewportContent()
-	case "left":
+	case keys.Matches(msg, keys.Left):
 		m.historyNav.PrevPage()
 		m.updateViewportContent()
-	case "right":
+	case keys.Matches(msg, keys.Right):
 		m.historyNav.NextPage()
 		m.updateViewportContent()
-	case "enter":
+	case keys.Matches(msg, keys.Select):
 		if len(m.sessionList) > 0 && m.historyNav.SelectedIndex < len(m.sessionList) {
 			m.selectedSession = &m.sessionList[m.historyNav.SelectedIndex]
 			m.sessionDetailMode = SessionModeSummary
@@ -334,13 +379,13 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleSessionDetailKeys handles session detail view keys.
 func (m Model) handleSessionDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc", "h", "H":
+	switch {
+	case keys.Matches(msg, keys.Back, keys.ShowHistory):
 		m.view = ViewHistory
 		m.selectedSession = nil
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "l", "L":
+	case keys.Matches(msg, keys.ShowLog):
 		if m.selectedSession != nil && len(m.selectedSession.Questions) > minQuestionsForFullLog {
 			m.sessionDetailMode = SessionModeFullLog
 			m.sessionLogNav.Reset(len(m.selectedSession.Questions))
@@ -348,6 +393,8 @@ func (m Model) handleSessionDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 			m.updateViewportContent()
 			m.viewport.GotoTop()
 		}
+	case keys.Matches(msg, keys.Replay):
+		return m.startReplay()
 	default:
 		// Let viewport handle scrolling
 		var cmd tea.Cmd
@@ -359,13 +406,15 @@ func (m Model) handleSessionDetailKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleSessionLogKeys handles session log view keys.
 func (m Model) handleSessionLogKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc", "s", "S":
+	switch {
+	case keys.Matches(msg, keys.Back, keys.ShowSummary):
 		m.view = ViewSessionDetail
 		m.sessionDetailMode = SessionModeSummary
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "left", "h":
+	case keys.Matches(msg, keys.Replay):
+		return m.startReplay()
+	case keys.Matches(msg, keys.Left):
 		m.sessionLogNav.PrevFilter()
 		// Update total based on filtered count
 		if m.selectedSession != nil {
@@ -374,7 +423,7 @@ func (m Model) handleSessionLogKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 		}
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "right", "l":
+	case keys.Matches(msg, keys.Right):
 		m.sessionLogNav.NextFilter()
 		if m.selectedSession != nil {
 			filtered := FilterQuestions(m.selectedSession.Questions, m.sessionLogNav.Filter)
@@ -391,18 +440,57 @@ func (m Model) handleSessionLogKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// startReplay opens the replay of the selected session and starts playback.
+func (m Model) startReplay() (Model, tea.Cmd) {
+	if m.selectedSession == nil || len(m.selectedSession.Questions) == 0 {
+		return m, nil
+	}
+	m.replays++
+	m.replay = NewReplay(*m.selectedSession, m.replays)
+	m.replayReturn = m.view
+	m.view = ViewSessionReplay
+	cmd := m.replay.Start()
+	m.updateViewportContent()
+	m.viewport.GotoTop()
+	return m, cmd
+}
+
+// handleSessionReplayKeys handles session replay view keys.
+func (m Model) handleSessionReplayKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch {
+	case keys.Matches(msg, keys.Back):
+		// Leaving the view stops playback; remaining ticks are ignored.
+		m.view = m.replayReturn
+		m.updateViewportContent()
+		m.viewport.GotoTop()
+		return m, nil
+	case keys.Matches(msg, keys.Toggle):
+		cmd = m.replay.TogglePause()
+	case keys.Matches(msg, keys.Replay):
+		cmd = m.replay.Restart()
+	case keys.Matches(msg, keys.Speed1):
+		m.replay.SetSpeed(1)
+	case keys.Matches(msg, keys.Speed2):
+		m.replay.SetSpeed(2)
+	case keys.Matches(msg, keys.Speed4):
+		m.replay.SetSpeed(4)
+	}
+	return m, cmd
+}
+
 // handleTrendsKeys handles trends view keys.
 func (m Model) handleTrendsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "esc":
+	switch {
+	case keys.Matches(msg, keys.Back):
 		m.view = ViewDashboard
 		m.updateViewportContent()
 		m.viewport.GotoTop()
-	case "m", "M":
+	case keys.Matches(msg, keys.Metric):
 		m.trendsState.NextMetric()
 		m.updateTrendsData()
 		m.updateViewportContent()
-	case "p", "P":
+	case keys.Matches(msg, keys.Period):
 		m.trendsState.NextPeriod()
 		m.updateTrendsData()
 		m.updateViewportContent()
@@ -415,14 +503,26 @@ func (m Model) handleTrendsKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
 	return m, nil
 }
 
-// applyFilters recomputes data after filter changes.
+// applyFilters recomputes data after filter changes. Sessions outside the
+// period or difficulty are left out of the already loaded statistics, so a
+// filter change never touches storage.
 func (m *Model) applyFilters() {
 	if m.stats == nil {
 		return
 	}
 
 	filter := m.filterPanel.GetFilters()
-	m.aggregates = analytics.ComputeFilteredAggregates(m.stats, filter)
+	m.filtered = m.stats
+	if q := filter.SessionQuery(); q != (storage.SessionQuery{}) {
+		matched := make([]storage.SessionRecord, 0, len(m.stats.Sessions))
+		for _, s := range m.stats.Sessions {
+			if q.Matches(s) {
+				matched = append(matched, s)
+			}
+		}
+		m.filtered = &storage.Statistics{Sessions: matched}
+	}
+	m.aggregates = analytics.ComputeFilteredAggregates(m.filtered, filter)
 	m.rebuildLists()
 }
 
@@ -435,13 +535,13 @@ func (m *Model) rebuildLists() {
 	filter := m.filterPanel.GetFilters()
 
 	// Rebuild operation list
-	m.operationList = BuildOperationList(m.aggregates, m.stats, filter)
+	m.operationList = BuildOperationList(m.aggregates, m.filtered, filter)
 	if m.operationIndex >= len(m.operationList) {
 		m.operationIndex = 0
 	}
 
 	// Rebuild session list
-	m.sessionList = analytics.GetSessionsByFilter(m.stats, filter)
+	m.sessionList = analytics.GetSessionsByFilter(m.filtered, filter)
 	m.historyNav.Reset(len(m.sessionList))
 }
 
@@ -458,7 +558,7 @@ func (m *Model) updateTrendsData() {
 // View renders the current view.
 func (m Model) View() string {
 	if m.err != nil {
-		return RenderError(m.width, m.height)
+		return RenderError(m.err, m.width, m.height)
 	}
 
 	if m.loading || m.stats == nil {
@@ -484,98 +584,129 @@ func (m Model) View() string {
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		mainArea,
-		lipgloss.Place(m.width, components.HintsHeight, lipgloss.Center, lipgloss.Center, hints),
+		lipgloss.Place(m.width, m.hintsHeight(), lipgloss.Center, lipgloss.Center, hints),
 	)
 }
 
-// getHints returns the context-aware hints for the current view.
-func (m Model) getHints() string {
+// hintList returns the context-aware hints for the current view.
+func (m Model) hintList() []components.Hint {
 	switch m.view {
 	case ViewDashboard:
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "O", Action: "Operations"},
-			{Key: "H", Action: "History"},
-			{Key: "T", Action: "Trends"},
-		}, m.width)
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Operations", keys.ShowOperations),
+			components.KeyHint("History", keys.ShowHistory),
+			components.KeyHint("Trends", keys.ShowTrends),
+		}
 
 	case ViewOperations:
 		hintList := []components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "c", Action: "Category"},
-			{Key: "d", Action: "Difficulty"},
-			{Key: "p", Action: "Period"},
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Category", keys.Category),
+			components.KeyHint("Difficulty", keys.Difficulty),
+			components.KeyHint("Period", keys.Period),
 		}
 		if len(m.operationList) > 0 {
 			hintList = append(hintList,
-				components.Hint{Key: "↑↓", Action: "Navigate"},
-				components.Hint{Key: "Enter", Action: "Details"},
+				components.KeyHint("Navigate", keys.Up, keys.Down),
+				components.KeyHint("Details", keys.Select),
 			)
 		}
-		return components.RenderHintsResponsive(hintList, m.width)
+		return hintList
 
 	case ViewOperationDetail:
 		hintList := []components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "←→", Action: "Filter"},
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Filter", keys.Left, keys.Right),
 		}
 		if m.opHasMistakes {
-			hintList = append(hintList, components.Hint{Key: "R", Action: "Review All"})
+			hintList = append(hintList, components.KeyHint("Review All", keys.Mistakes))
 		}
-		return components.RenderHintsResponsive(hintList, m.width)
+		return hintList
 
 	case ViewOperationReview:
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "↑↓", Action: "Scroll"},
-			{Key: "PgUp/Dn", Action: "Jump"},
-		}, m.width)
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Scroll", keys.Up, keys.Down),
+			components.KeyHint("Jump", keys.PageUp, keys.PageDown),
+		}
 
 	case ViewHistory:
 		hintList := []components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "c", Action: "Category"},
-			{Key: "d", Action: "Difficulty"},
-			{Key: "p", Action: "Period"},
-			{Key: "↑↓", Action: "Navigate"},
-			{Key: "Enter", Action: "Details"},
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Category", keys.Category),
+			components.KeyHint("Difficulty", keys.Difficulty),
+			components.KeyHint("Period", keys.Period),
+			components.KeyHint("Navigate", keys.Up, keys.Down),
+			components.KeyHint("Details", keys.Select),
 		}
-		return components.RenderHintsResponsive(hintList, m.width)
+		return hintList
 
 	case ViewSessionDetail:
+		hintList := []components.Hint{
+			components.KeyHint("Back", keys.Back),
+		}
 		if m.selectedSession != nil && len(m.selectedSession.Questions) > minQuestionsForFullLog {
-			return components.RenderHintsResponsive([]components.Hint{
-				{Key: "Esc", Action: "Back"},
-				{Key: "L", Action: "Full Log"},
-				{Key: "H", Action: "History"},
-			}, m.width)
+			hintList = append(hintList, components.KeyHint("Full Log", keys.ShowLog))
+		}
+		if m.selectedSession != nil && len(m.selectedSession.Questions) > 0 {
+			hintList = append(hintList, components.KeyHint("Replay", keys.Replay))
 		}
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "H", Action: "History"},
-		}, m.width)
+		hintList = append(hintList, components.KeyHint("History", keys.ShowHistory))
+		return hintList
 
 	case ViewSessionFullLog:
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "↑↓", Action: "Scroll"},
-			{Key: "←→", Action: "Filter"},
-			{Key: "PgUp/Dn", Action: "Jump"},
-			{Key: "S", Action: "Summary"},
-		}, m.width)
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Scroll", keys.Up, keys.Down),
+			components.KeyHint("Filter", keys.Left, keys.Right),
+			components.KeyHint("Jump", keys.PageUp, keys.PageDown),
+			components.KeyHint("Replay", keys.Replay),
+			components.KeyHint("Summary", keys.ShowSummary),
+		}
+
+	case ViewSessionReplay:
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Pause", keys.Toggle),
+			components.KeyHint("Speed", keys.Speed1, keys.Speed2, keys.Speed4),
+			components.KeyHint("Restart", keys.Replay),
+		}
 
 	case ViewTrends:
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-			{Key: "m", Action: "Metric"},
-			{Key: "p", Action: "Period"},
-		}, m.width)
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+			components.KeyHint("Metric", keys.Metric),
+			components.KeyHint("Period", keys.Period),
+		}
 
 	default:
-		return components.RenderHintsResponsive([]components.Hint{
-			{Key: "Esc", Action: "Back"},
-		}, m.width)
+		return []components.Hint{
+			components.KeyHint("Back", keys.Back),
+		}
+	}
+}
+
+// getHints renders the hints for the current view, abbreviated on a
+// single line in the compact layout.
+func (m Model) getHints() string {
+	if m.compact() {
+		return components.RenderHintsCompact(m.hintList(), m.width)
+	}
+	return components.RenderHintsResponsive(m.hintList(), m.width)
+}
+
+// compact reports whether the screen uses the compact layout.
+func (m Model) compact() bool {
+	return components.IsCompact(m.width, m.height)
+}
+
+// hintsHeight returns the height reserved for the hints.
+func (m Model) hintsHeight() int {
+	if m.compact() {
+		return components.CompactHintsHeight
 	}
+	return components.HintsHeight
 }
 
 // SetSize sets the screen dimensions.
@@ -592,7 +723,7 @@ func (m *Model) SetSize(width, height int) {
 
 // calculateViewportHeight returns the viewport height.
 func (m Model) calculateViewportHeight() int {
-	viewportHeight := m.height - components.HintsHeight
+	viewportHeight := m.height - m.hintsHeight()
 	if viewportHeight < 1 {
 		viewportHeight = 1
 	}
@@ -615,7 +746,18 @@ func (m Model) renderCurrentViewContent() string {
 
 	switch m.view {
 	case ViewDashboard:
-		content = RenderDashboardContent(m.aggregates, m.width)
+		if m.compact() {
+			content = RenderDashboardCompactContent(m.aggregates, m.width)
+		} else {
+			content = RenderDashboardContent(m.aggregates, m.width)
+		}
+		if m.recovery != nil {
+			content = lipgloss.JoinVertical(lipgloss.Center,
+				RenderRecoveryNotice(*m.recovery),
+				"",
+				content,
+			)
+		}
 
 	case ViewOperations:
 		content = RenderOperationsContent(
@@ -678,6 +820,9 @@ func (m Model) renderCurrentViewContent() string {
 			m.width,
 		)
 
+	case ViewSessionReplay:
+		content = m.replay.View(m.width)
+
 	case ViewTrends:
 		content = RenderTrendsContent(m.trendsState, m.aggregates, m.width)
 
@@ -702,8 +847,15 @@ func RenderLoading(width, height int) string {
 }
 
 // RenderError renders the error state.
-func RenderError(width, height int) string {
-	content := "STATISTICS\n\nError loading statistics\n\n[Esc] Back"
+func RenderError(err error, width, height int) string {
+	content := lipgloss.JoinVertical(lipgloss.Center,
+		styles.Current().Bold.Render("STATISTICS"),
+		"",
+		styles.Current().Incorrect.Render("Your statistics could not be loaded."),
+		styles.Current().Dim.Render(err.Error()),
+		"",
+		"[Esc] Back",
+	)
 	if width > 0 && height > 0 {
 		return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
 	}
@@ -0,0 +1,93 @@
+package statistics
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// useSQLite points storage at a temporary profile using the SQLite backend.
+func useSQLite(t *testing.T) {
+	t.Helper()
+	storage.SetConfigDirForTesting(t.TempDir())
+	t.Cleanup(func() { storage.SetConfigDirForTesting("") })
+
+	config := storage.NewConfig()
+	config.StorageBackend = storage.BackendSQLite
+	if err := storage.SaveConfig(config); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFiltersUseLoadedSessions(t *testing.T) {
+	useSQLite(t)
+	backend, err := storage.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, diff := range []string{"Easy", "Hard", "Hard"} {
+		s := replaySession()
+		s.ID = string(rune('a' + i))
+		s.Timestamp = time.Now().Add(-time.Duration(i) * time.Hour)
+		s.Difficulty = diff
+		if err := backend.AddSession(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = backend.Close()
+
+	m := New()
+	m.SetSize(80, 24)
+	m, cmd := m.Update(loadStatisticsMsg{})
+	m, _ = m.Update(cmd())
+	if len(m.sessionList) != 3 {
+		t.Fatalf("loaded %d sessions, want 3", len(m.sessionList))
+	}
+
+	// All → Beginner → Easy → Medium → Hard
+	for i := 0; i < 4; i++ {
+		m.filterPanel.CycleDifficulty()
+	}
+
+	// Filtering must not go back to storage: a store that has since become
+	// unreadable leaves the loaded sessions usable.
+	path, err := storage.SQLitePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Repeat("not a database ", 512)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	m.applyFilters()
+	if m.err != nil {
+		t.Fatal(m.err)
+	}
+	if len(m.filtered.Sessions) != 2 || len(m.sessionList) != 2 {
+		t.Errorf("Hard filter: kept %d sessions, listed %d; want 2", len(m.filtered.Sessions), len(m.sessionList))
+	}
+}
+
+func TestDamagedDatabaseIsReported(t *testing.T) {
+	useSQLite(t)
+	path, err := storage.SQLitePath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Repeat("not a database ", 512)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	m := New()
+	m.SetSize(80, 24)
+	m, cmd := m.Update(loadStatisticsMsg{})
+	m, _ = m.Update(cmd())
+	if m.err == nil {
+		t.Fatal("damaged database loaded without an error")
+	}
+	if view := m.View(); !strings.Contains(view, "could not be loaded") {
+		t.Errorf("view does not report the damaged database:\n%s", view)
+	}
+}
@@ -24,7 +24,7 @@ func RenderOperationDetailContent(
 
 	// Title
 	title := fmt.Sprintf("STATISTICS · %s", strings.ToUpper(operation))
-	b.WriteString(styles.Bold.Render(title))
+	b.WriteString(styles.Current().Bold.Render(title))
 	b.WriteString("\n\n")
 
 	// Filter status
@@ -32,7 +32,7 @@ func RenderOperationDetailContent(
 	if difficultyFilter != "" {
 		diffDisplay = difficultyFilter
 	}
-	b.WriteString(styles.Dim.Render(diffDisplay))
+	b.WriteString(styles.Current().Dim.Render(diffDisplay))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -40,13 +40,13 @@ func RenderOperationDetailContent(
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	// Summary section
-	b.WriteString(styles.Bold.Render("SUMMARY"))
+	b.WriteString(styles.Current().Bold.Render("SUMMARY"))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render("───────"))
+	b.WriteString(styles.Current().Dim.Render("───────"))
 	b.WriteString("\n")
 
 	labelWidth := 16
@@ -65,9 +65,9 @@ func RenderOperationDetailContent(
 
 	// By difficulty section
 	if len(extStats.ByDifficulty) > 0 {
-		b.WriteString(styles.Bold.Render("BY DIFFICULTY"))
+		b.WriteString(styles.Current().Bold.Render("BY DIFFICULTY"))
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("─────────────"))
+		b.WriteString(styles.Current().Dim.Render("─────────────"))
 		b.WriteString("\n")
 
 		barWidth := components.ProgressBarWidth(width)
@@ -85,13 +85,13 @@ func RenderOperationDetailContent(
 	}
 
 	// Recent mistakes section
-	b.WriteString(styles.Bold.Render(fmt.Sprintf("RECENT MISTAKES (%d)", len(mistakes))))
+	b.WriteString(styles.Current().Bold.Render(fmt.Sprintf("RECENT MISTAKES (%d)", len(mistakes))))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render("───────────────────"))
+	b.WriteString(styles.Current().Dim.Render("───────────────────"))
 	b.WriteString("\n")
 
 	if len(mistakes) == 0 {
-		b.WriteString(styles.Correct.Render("No mistakes yet - perfect!"))
+		b.WriteString(styles.Current().Correct.Render("No mistakes yet - perfect!"))
 		b.WriteString("\n")
 	} else {
 		for _, m := range mistakes {
@@ -101,7 +101,7 @@ func RenderOperationDetailContent(
 				m.UserAnswer,
 				FormatRelativeTime(m.SessionDate),
 			)
-			b.WriteString(styles.Incorrect.Render(mistakeLine))
+			b.WriteString(styles.Current().Incorrect.Render(mistakeLine))
 			b.WriteString("\n")
 		}
 	}
@@ -119,9 +119,9 @@ func RenderOperationReviewContent(
 
 	// Title
 	title := fmt.Sprintf("STATISTICS · %s · REVIEW", strings.ToUpper(operation))
-	b.WriteString(styles.Bold.Render(title))
+	b.WriteString(styles.Current().Bold.Render(title))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render("All Mistakes"))
+	b.WriteString(styles.Current().Dim.Render("All Mistakes"))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -129,18 +129,18 @@ func RenderOperationReviewContent(
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	if len(allMistakes) == 0 {
-		b.WriteString(styles.Correct.Render("No mistakes - perfect!"))
+		b.WriteString(styles.Current().Correct.Render("No mistakes - perfect!"))
 		b.WriteString("\n")
 	} else {
 		// Column header
 		headerLine := fmt.Sprintf("  #   %-25s  %-8s  %-8s  %s", "Question", "You", "Correct", "When")
-		b.WriteString(styles.Dim.Render(headerLine))
+		b.WriteString(styles.Current().Dim.Render(headerLine))
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+		b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 		b.WriteString("\n")
 
 		// Render all mistakes - viewport handles scrolling
@@ -158,13 +158,13 @@ func RenderOperationReviewContent(
 				m.CorrectAnswer,
 				FormatRelativeTime(m.SessionDate),
 			)
-			b.WriteString(styles.Incorrect.Render(line))
+			b.WriteString(styles.Current().Incorrect.Render(line))
 			b.WriteString("\n")
 		}
 
 		// Total info
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render(fmt.Sprintf("Total: %d mistakes", len(allMistakes))))
+		b.WriteString(styles.Current().Dim.Render(fmt.Sprintf("Total: %d mistakes", len(allMistakes))))
 	}
 
 	return b.String()
@@ -58,7 +58,7 @@ func RenderOperationsContent(
 	var b strings.Builder
 
 	// Title
-	b.WriteString(styles.Bold.Render("STATISTICS · OPERATIONS"))
+	b.WriteString(styles.Current().Bold.Render("STATISTICS · OPERATIONS"))
 	b.WriteString("\n\n")
 
 	// Filter status
@@ -67,7 +67,7 @@ func RenderOperationsContent(
 		filterPanel.GetDifficultyDisplay(),
 		filterPanel.GetTimePeriodDisplay(),
 	)
-	b.WriteString(styles.Dim.Render(filterLine))
+	b.WriteString(styles.Current().Dim.Render(filterLine))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -75,15 +75,15 @@ func RenderOperationsContent(
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	// Empty state
 	if len(rows) == 0 {
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("No data for these filters yet."))
+		b.WriteString(styles.Current().Dim.Render("No data for these filters yet."))
 		b.WriteString("\n\n")
-		b.WriteString(styles.Dim.Render("Try different filters or play more games!"))
+		b.WriteString(styles.Current().Dim.Render("Try different filters or play more games!"))
 		b.WriteString("\n")
 	} else {
 		// Group by category
@@ -95,9 +95,9 @@ func RenderOperationsContent(
 					b.WriteString("\n")
 				}
 				currentCategory = row.Category
-				b.WriteString(styles.Bold.Render(strings.ToUpper(currentCategory)))
+				b.WriteString(styles.Current().Bold.Render(strings.ToUpper(currentCategory)))
 				b.WriteString("\n")
-				b.WriteString(styles.Dim.Render(strings.Repeat("─", len(currentCategory)+2)))
+				b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", len(currentCategory)+2)))
 				b.WriteString("\n")
 			}
 
@@ -126,21 +126,21 @@ func renderOperationRow(row OperationRow, selected bool, termWidth int) string {
 	// Selection indicator
 	prefix := "  "
 	if selected {
-		prefix = styles.Accent.Render("▸ ")
+		prefix = styles.Current().Accent.Render("▸ ")
 	}
 
 	// Color the accuracy
 	if row.Accuracy >= 80 {
-		accStr = styles.Correct.Render(accStr)
+		accStr = styles.Current().Correct.Render(accStr)
 	} else if row.Accuracy < 60 {
-		accStr = styles.Incorrect.Render(accStr)
+		accStr = styles.Current().Incorrect.Render(accStr)
 	}
 
 	line := fmt.Sprintf("%s%s  %s  %s  %s  %-10s  %s",
 		prefix, symbol, name, accStr, bar, counts, timeStr)
 
 	if selected {
-		return styles.Bold.Render(line)
+		return styles.Current().Bold.Render(line)
 	}
 	return line
 }
@@ -0,0 +1,297 @@
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+)
+
+// Replay timing constants
+const (
+	// replayTickInterval is how often the replay advances in real time.
+	replayTickInterval = 100 * time.Millisecond
+	// replayTypeWindow is the longest a recorded answer takes to be typed out.
+	replayTypeWindow = 800 * time.Millisecond
+	// replayFeedbackTime is how long the result of an answer stays visible.
+	replayFeedbackTime = 1 * time.Second
+)
+
+// ReplaySpeeds are the selectable playback speeds.
+var ReplaySpeeds = []int{1, 2, 4}
+
+// replayTickMsg advances the replay with the given serial and tick chain.
+// Ticks from an earlier replay or from before a pause carry an old serial or
+// id and are ignored.
+type replayTickMsg struct {
+	serial int
+	id     int
+}
+
+// replayFrame is the state of a replayed session at one point in game time.
+type replayFrame struct {
+	Answered int                     // Questions answered so far
+	Score    int                     // Score after the answered questions
+	Streak   int                     // Current streak after the answered questions
+	Current  *storage.QuestionRecord // Question on screen (nil when none is left)
+	Typed    string                  // Part of the recorded answer typed so far
+	Last     *storage.QuestionRecord // Most recent answer while its feedback shows
+}
+
+// ReplayModel plays back a recorded session on a timeline built from the
+// question offsets. It only reads the record; no game session is involved.
+type ReplayModel struct {
+	session storage.SessionRecord
+	offsets []time.Duration
+	end     time.Duration // Game time at which the replay stops
+
+	serial  int // Identifies this replay among those opened by the screen
+	id      int // Identifies the current tick chain
+	elapsed time.Duration
+	speed   int
+	playing bool
+	tick    int // for the scoreboard shimmer (increments each game second)
+
+	input components.InputModel
+}
+
+// NewReplay creates a paused replay of a session at 1× speed. The serial
+// tells its ticks apart from those of replays opened before it.
+func NewReplay(session storage.SessionRecord, serial int) ReplayModel {
+	offsets := session.AnswerOffsets()
+	end := time.Duration(session.DurationSeconds) * time.Second
+	if n := len(offsets); n > 0 && offsets[n-1] > end {
+		end = offsets[n-1]
+	}
+
+	input := components.NewInput()
+	input.Blur()
+
+	return ReplayModel{
+		session: session,
+		serial:  serial,
+		offsets: offsets,
+		end:     end,
+		speed:   ReplaySpeeds[0],
+		input:   input,
+	}
+}
+
+// Start starts or resumes playback.
+func (m *ReplayModel) Start() tea.Cmd {
+	if m.Finished() {
+		m.elapsed = 0
+		m.tick = 0
+	}
+	m.playing = true
+	// A new id drops any tick still in flight, so only one chain runs.
+	m.id++
+	return m.tickCmd()
+}
+
+// TogglePause pauses or resumes playback.
+func (m *ReplayModel) TogglePause() tea.Cmd {
+	if m.playing {
+		m.playing = false
+		return nil
+	}
+	return m.Start()
+}
+
+// Restart plays the session again from the beginning.
+func (m *ReplayModel) Restart() tea.Cmd {
+	m.elapsed = m.end
+	return m.Start()
+}
+
+// SetSpeed sets the playback speed multiplier.
+func (m *ReplayModel) SetSpeed(speed int) {
+	m.speed = speed
+}
+
+// Finished reports whether playback reached the end of the session.
+func (m ReplayModel) Finished() bool {
+	return m.elapsed >= m.end
+}
+
+func (m ReplayModel) tickCmd() tea.Cmd {
+	serial, id := m.serial, m.id
+	return tea.Tick(replayTickInterval, func(time.Time) tea.Msg {
+		return replayTickMsg{serial: serial, id: id}
+	})
+}
+
+// Update advances playback on ticks of this replay.
+func (m ReplayModel) Update(msg tea.Msg) (ReplayModel, tea.Cmd) {
+	tick, ok := msg.(replayTickMsg)
+	if !ok || tick.serial != m.serial || tick.id != m.id || !m.playing {
+		return m, nil
+	}
+
+	before := m.elapsed
+	m.elapsed += replayTickInterval * time.Duration(m.speed)
+	if m.elapsed >= m.end {
+		m.elapsed = m.end
+		m.playing = false
+	}
+	if m.elapsed/time.Second != before/time.Second {
+		m.tick++
+	}
+	if !m.playing {
+		return m, nil
+	}
+	return m, m.tickCmd()
+}
+
+// frame computes what is on screen at the given game time.
+func (m ReplayModel) frame(elapsed time.Duration) replayFrame {
+	var f replayFrame
+	questions := m.session.Questions
+	for f.Answered < len(questions) && m.offsets[f.Answered] <= elapsed {
+		q := questions[f.Answered]
+		f.Score += q.PointsEarned
+		if q.Correct && !q.Skipped {
+			f.Streak++
+		} else {
+			f.Streak = 0
+		}
+		f.Answered++
+	}
+
+	if f.Answered > 0 && elapsed-m.offsets[f.Answered-1] < replayFeedbackTime {
+		f.Last = &questions[f.Answered-1]
+	}
+
+	if f.Answered < len(questions) {
+		f.Current = &questions[f.Answered]
+		if !f.Current.Skipped {
+			f.Typed = typedAnswer(f.Current, m.offsets[f.Answered]-elapsed)
+		}
+	}
+	return f
+}
+
+// typedAnswer returns the part of a recorded answer typed with the given
+// time left until it was submitted. Digits appear evenly over the last part
+// of the response time.
+func typedAnswer(q *storage.QuestionRecord, untilAnswer time.Duration) string {
+	answer := strconv.Itoa(q.UserAnswer)
+	window := min(time.Duration(q.ResponseTimeMs)*time.Millisecond, replayTypeWindow)
+	if window <= 0 || untilAnswer <= 0 {
+		return answer
+	}
+	if untilAnswer >= window {
+		return ""
+	}
+	n := int(math.Ceil(float64(len(answer)) * float64(window-untilAnswer) / float64(window)))
+	return answer[:n]
+}
+
+// View renders the replay with the game screen components.
+func (m ReplayModel) View(width int) string {
+	f := m.frame(m.elapsed)
+
+	title := styles.Current().Bold.Render(fmt.Sprintf("REPLAY · %s", m.session.Mode))
+	status := styles.Current().Dim.Render(m.statusText())
+
+	// Top row: scoreboard | score | timer, as in the game
+	var delta string
+	if f.Last != nil && f.Last.PointsEarned != 0 {
+		delta = components.RenderScoreDelta(f.Last.PointsEarned)
+	}
+	score := lipgloss.JoinVertical(lipgloss.Center,
+		styles.Current().Dim.Render("Score"),
+		components.RenderScoreLarge(f.Score),
+		delta,
+	)
+	timer := lipgloss.JoinVertical(lipgloss.Right,
+		styles.Current().Dim.Render(m.timerLabel()),
+		components.FormatTimer(m.timerValue()),
+	)
+	topRow := lipgloss.JoinHorizontal(lipgloss.Top,
+		components.RenderScoreboard(f.Streak, m.tick),
+		"    ",
+		score,
+		"    ",
+		timer,
+	)
+
+	// Question and recorded answer
+	var question, answer string
+	if f.Current != nil {
+		question = components.RenderQuestion(f.Current.Question)
+		input := m.input
+		input.SetValue(f.Typed)
+		answer = input.View()
+	} else {
+		question = styles.Current().Dim.Render("Session over")
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		status,
+		"",
+		topRow,
+		"",
+		"",
+		question,
+		"",
+		answer,
+		renderReplayFeedback(f.Last),
+	)
+	if width > 0 {
+		return lipgloss.PlaceHorizontal(width, lipgloss.Center, content)
+	}
+	return content
+}
+
+// statusText describes playback: speed, state and progress.
+func (m ReplayModel) statusText() string {
+	state := "Playing"
+	switch {
+	case m.Finished():
+		state = "Finished"
+	case !m.playing:
+		state = "Paused"
+	}
+	f := m.frame(m.elapsed)
+	return fmt.Sprintf("%d× · %s · %d/%d", m.speed, state, f.Answered, len(m.session.Questions))
+}
+
+// timerLabel and timerValue count down for timed sessions and up otherwise.
+func (m ReplayModel) timerLabel() string {
+	if m.session.DurationSeconds > 0 {
+		return "Remaining"
+	}
+	return "Elapsed"
+}
+
+func (m ReplayModel) timerValue() time.Duration {
+	if m.session.DurationSeconds > 0 {
+		return max(time.Duration(m.session.DurationSeconds)*time.Second-m.elapsed, 0)
+	}
+	return m.elapsed
+}
+
+// renderReplayFeedback shows the result of the most recent answer.
+func renderReplayFeedback(q *storage.QuestionRecord) string {
+	switch {
+	case q == nil:
+		return ""
+	case q.TimedOut:
+		return styles.Current().Incorrect.Render("Time's up · " + strconv.Itoa(q.CorrectAnswer))
+	case q.Skipped:
+		return styles.Current().Dim.Render("Skipped · " + strconv.Itoa(q.CorrectAnswer))
+	case q.Correct:
+		return styles.Current().Correct.Render("✓ " + strconv.Itoa(q.UserAnswer))
+	default:
+		return styles.Current().Incorrect.Render(fmt.Sprintf("✗ %d · correct %d", q.UserAnswer, q.CorrectAnswer))
+	}
+}
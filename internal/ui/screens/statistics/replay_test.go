@@ -0,0 +1,90 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+func replaySession() storage.SessionRecord {
+	return storage.SessionRecord{
+		Mode:            "Addition",
+		DurationSeconds: 10,
+		Questions: []storage.QuestionRecord{
+			{Question: "1 + 2", CorrectAnswer: 3, UserAnswer: 3, Correct: true, ResponseTimeMs: 2000, PointsEarned: 100, OffsetMs: 2000},
+			{Question: "4 + 5", CorrectAnswer: 9, UserAnswer: 8, ResponseTimeMs: 1000, PointsEarned: -25, OffsetMs: 3500},
+			{Question: "6 + 6", CorrectAnswer: 12, UserAnswer: 12, Correct: true, ResponseTimeMs: 1500, PointsEarned: 110, OffsetMs: 5500},
+		},
+	}
+}
+
+func TestReplayFrame(t *testing.T) {
+	m := NewReplay(replaySession(), 1)
+
+	tests := []struct {
+		elapsed  time.Duration
+		answered int
+		score    int
+		streak   int
+		typed    string
+		feedback bool
+	}{
+		{0, 0, 0, 0, "", false},
+		{1800 * time.Millisecond, 0, 0, 0, "3", false},
+		{2 * time.Second, 1, 100, 1, "", true},
+		{3500 * time.Millisecond, 2, 75, 0, "", true},
+		{5 * time.Second, 2, 75, 0, "1", false},
+		{10 * time.Second, 3, 185, 1, "", false},
+	}
+	for _, tt := range tests {
+		f := m.frame(tt.elapsed)
+		if f.Answered != tt.answered || f.Score != tt.score || f.Streak != tt.streak {
+			t.Errorf("frame(%v) = %d answered, score %d, streak %d; want %d, %d, %d",
+				tt.elapsed, f.Answered, f.Score, f.Streak, tt.answered, tt.score, tt.streak)
+		}
+		if f.Typed != tt.typed {
+			t.Errorf("frame(%v).Typed = %q, want %q", tt.elapsed, f.Typed, tt.typed)
+		}
+		if (f.Last != nil) != tt.feedback {
+			t.Errorf("frame(%v).Last = %v, want feedback %v", tt.elapsed, f.Last, tt.feedback)
+		}
+	}
+	if f := m.frame(10 * time.Second); f.Current != nil {
+		t.Errorf("frame at end shows %q, want no question", f.Current.Question)
+	}
+}
+
+func TestReplayPlayback(t *testing.T) {
+	m := NewReplay(replaySession(), 1)
+	m.SetSpeed(4)
+	if m.Start() == nil {
+		t.Fatal("Start() returned no tick")
+	}
+	stale := replayTickMsg{serial: m.serial, id: m.id}
+
+	// Pausing and resuming starts a new tick chain; the old tick is ignored.
+	m.TogglePause()
+	m.TogglePause()
+	m, _ = m.Update(stale)
+	if m.elapsed != 0 {
+		t.Errorf("stale tick advanced replay to %v", m.elapsed)
+	}
+
+	// Neither is a tick from an earlier replay with the same chain id.
+	m, _ = m.Update(replayTickMsg{serial: m.serial - 1, id: m.id})
+	if m.elapsed != 0 {
+		t.Errorf("tick from an earlier replay advanced replay to %v", m.elapsed)
+	}
+
+	cmd := m.tickCmd()
+	for i := 0; cmd != nil && i < 100; i++ {
+		m, cmd = m.Update(replayTickMsg{serial: m.serial, id: m.id})
+	}
+	if !m.Finished() || m.playing {
+		t.Errorf("replay not finished after ticking: elapsed %v", m.elapsed)
+	}
+	if m.elapsed != 10*time.Second {
+		t.Errorf("elapsed = %v, want 10s", m.elapsed)
+	}
+}
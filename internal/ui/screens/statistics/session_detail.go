@@ -78,16 +78,16 @@ func RenderSessionSummaryContent(session storage.SessionRecord, width int) strin
 
 	// Title
 	title := fmt.Sprintf("SESSION · %s", session.Mode)
-	b.WriteString(styles.Bold.Render(title))
+	b.WriteString(styles.Current().Bold.Render(title))
 	b.WriteString("\n\n")
 
 	// Session metadata
 	dateStr := FormatSessionDate(session.Timestamp) + ", " + FormatTime(session.Timestamp)
-	b.WriteString(styles.Dim.Render("Date: " + dateStr))
+	b.WriteString(styles.Current().Dim.Render("Date: " + dateStr))
 	b.WriteString("\n")
 	metaLine := fmt.Sprintf("Mode: %s  •  Difficulty: %s  •  %s",
 		session.Mode, session.Difficulty, FormatDuration(session.DurationSeconds))
-	b.WriteString(styles.Dim.Render(metaLine))
+	b.WriteString(styles.Current().Dim.Render(metaLine))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -95,13 +95,13 @@ func RenderSessionSummaryContent(session storage.SessionRecord, width int) strin
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	// Results section
-	b.WriteString(styles.Bold.Render("RESULTS"))
+	b.WriteString(styles.Current().Bold.Render("RESULTS"))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render("───────"))
+	b.WriteString(styles.Current().Dim.Render("───────"))
 	b.WriteString("\n")
 
 	labelWidth := 16
@@ -125,16 +125,16 @@ func RenderSessionSummaryContent(session storage.SessionRecord, width int) strin
 
 	// Mistakes section
 	mistakes := getMistakes(session)
-	b.WriteString(styles.Bold.Render(fmt.Sprintf("MISTAKES (%d)", len(mistakes))))
+	b.WriteString(styles.Current().Bold.Render(fmt.Sprintf("MISTAKES (%d)", len(mistakes))))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render("────────────"))
+	b.WriteString(styles.Current().Dim.Render("────────────"))
 	b.WriteString("\n")
 
 	if len(mistakes) == 0 && session.QuestionsAttempted > 0 {
-		b.WriteString(styles.Correct.Render("Perfect session - no mistakes!"))
+		b.WriteString(styles.Current().Correct.Render("Perfect session - no mistakes!"))
 		b.WriteString("\n")
 	} else if len(mistakes) == 0 {
-		b.WriteString(styles.Dim.Render("No questions attempted"))
+		b.WriteString(styles.Current().Dim.Render("No questions attempted"))
 		b.WriteString("\n")
 	} else {
 		// Show all mistakes - viewport handles scrolling
@@ -147,7 +147,7 @@ func RenderSessionSummaryContent(session storage.SessionRecord, width int) strin
 				m.CorrectAnswer,
 				FormatResponseTime(m.ResponseTimeMs),
 			)
-			b.WriteString(styles.Incorrect.Render(line))
+			b.WriteString(styles.Current().Incorrect.Render(line))
 			b.WriteString("\n")
 		}
 	}
@@ -191,19 +191,19 @@ func RenderSessionFullLogContent(
 
 	// Title with full breadcrumb
 	title := fmt.Sprintf("SESSION · %s · Full Log", session.Mode)
-	b.WriteString(styles.Bold.Render(title))
+	b.WriteString(styles.Current().Bold.Render(title))
 	b.WriteString("\n\n")
 
 	// Filter selector
 	filterLine := fmt.Sprintf("Filter: ◀ %s ▶    (All / Correct / Wrong / Skipped)", filter.String())
-	b.WriteString(styles.Dim.Render(filterLine))
+	b.WriteString(styles.Current().Dim.Render(filterLine))
 	b.WriteString("\n\n")
 
 	// Column headers
 	headerLine := "  #    Question              Answer   Correct   Time"
-	b.WriteString(styles.Dim.Render(headerLine))
+	b.WriteString(styles.Current().Dim.Render(headerLine))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine)+10)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine)+10)))
 	b.WriteString("\n")
 
 	// Filter questions
@@ -211,7 +211,7 @@ func RenderSessionFullLogContent(
 
 	if len(filteredQuestions) == 0 {
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("No questions match this filter."))
+		b.WriteString(styles.Current().Dim.Render("No questions match this filter."))
 		b.WriteString("\n")
 	} else {
 		// Render all questions - viewport handles scrolling
@@ -221,11 +221,11 @@ func RenderSessionFullLogContent(
 		}
 
 		// Total info
-		b.WriteString(styles.Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine)+10)))
+		b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", lipgloss.Width(headerLine)+10)))
 		b.WriteString("\n")
 		totalInfo := fmt.Sprintf("Total: %d questions", len(filteredQuestions))
 		b.WriteString(lipgloss.Place(lipgloss.Width(headerLine)+10, 1, lipgloss.Center, lipgloss.Center,
-			styles.Dim.Render(totalInfo)))
+			styles.Current().Dim.Render(totalInfo)))
 	}
 
 	return b.String()
@@ -256,16 +256,18 @@ func renderQuestionRow(q storage.QuestionRecord, index int, showIndex bool) stri
 	// Result indicator
 	var resultStr string
 	if q.Skipped {
-		resultStr = styles.Dim.Render("⊘")
+		resultStr = styles.Current().Dim.Render("⊘")
 	} else if q.Correct {
-		resultStr = styles.Correct.Render("✓") + "        "
+		resultStr = styles.Current().Correct.Render("✓") + "        "
 	} else {
-		resultStr = styles.Incorrect.Render("✗") + " " + fmt.Sprintf("%-5d", q.CorrectAnswer)
+		resultStr = styles.Current().Incorrect.Render("✗") + " " + fmt.Sprintf("%-5d", q.CorrectAnswer)
 	}
 
-	// Time
+	// Time; a timed-out question shows how long it ran
 	timeStr := FormatResponseTime(q.ResponseTimeMs)
-	if q.Skipped {
+	if q.TimedOut {
+		timeStr += " (time up)"
+	} else if q.Skipped {
 		timeStr = "--"
 	}
 
@@ -85,12 +85,12 @@ func RenderTrendsContent(
 	var b strings.Builder
 
 	// Title
-	b.WriteString(styles.Bold.Render("STATISTICS · TRENDS"))
+	b.WriteString(styles.Current().Bold.Render("STATISTICS · TRENDS"))
 	b.WriteString("\n\n")
 
 	// Filter status
 	filterLine := state.Metric.String() + "  •  " + state.Period.String()
-	b.WriteString(styles.Dim.Render(filterLine))
+	b.WriteString(styles.Current().Dim.Render(filterLine))
 	b.WriteString("\n\n")
 
 	// Separator
@@ -98,17 +98,17 @@ func RenderTrendsContent(
 	if width > 0 && width-10 < separatorWidth {
 		separatorWidth = width - 10
 	}
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", separatorWidth)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", separatorWidth)))
 	b.WriteString("\n\n")
 
 	// Check if enough data
 	if agg.TotalSessions < 3 || len(state.TrendData.Points) < 2 {
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("Play a few more games to see trends!"))
+		b.WriteString(styles.Current().Dim.Render("Play a few more games to see trends!"))
 		b.WriteString("\n\n")
-		b.WriteString(styles.Dim.Render("Trends require at least 3 sessions over"))
+		b.WriteString(styles.Current().Dim.Render("Trends require at least 3 sessions over"))
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("multiple days to be meaningful."))
+		b.WriteString(styles.Current().Dim.Render("multiple days to be meaningful."))
 		b.WriteString("\n")
 
 		return b.String()
@@ -116,9 +116,9 @@ func RenderTrendsContent(
 
 	// Chart title
 	chartTitle := state.Metric.String() + " OVER TIME"
-	b.WriteString(styles.Bold.Render(chartTitle))
+	b.WriteString(styles.Current().Bold.Render(chartTitle))
 	b.WriteString("\n")
-	b.WriteString(styles.Dim.Render(strings.Repeat("─", len(chartTitle)+2)))
+	b.WriteString(styles.Current().Dim.Render(strings.Repeat("─", len(chartTitle)+2)))
 	b.WriteString("\n")
 
 	// Render the chart
@@ -136,9 +136,9 @@ func RenderTrendsContent(
 
 	// Sessions per week bar chart
 	if len(state.TrendData.SessionsPerWeek) > 0 {
-		b.WriteString(styles.Bold.Render("SESSIONS PER WEEK"))
+		b.WriteString(styles.Current().Bold.Render("SESSIONS PER WEEK"))
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("─────────────────"))
+		b.WriteString(styles.Current().Dim.Render("─────────────────"))
 		b.WriteString("\n")
 
 		barChart := RenderBarChart(state.TrendData.SessionsPerWeek, chartWidth+10)
@@ -148,9 +148,9 @@ func RenderTrendsContent(
 
 	// Insights
 	if len(state.Insights) > 0 {
-		b.WriteString(styles.Bold.Render("INSIGHTS"))
+		b.WriteString(styles.Current().Bold.Render("INSIGHTS"))
 		b.WriteString("\n")
-		b.WriteString(styles.Dim.Render("────────"))
+		b.WriteString(styles.Current().Dim.Render("────────"))
 		b.WriteString("\n")
 
 		for _, insight := range state.Insights {
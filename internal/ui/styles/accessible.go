@@ -0,0 +1,28 @@
+package styles
+
+import "os"
+
+// noColor is set when the NO_COLOR environment variable is present
+// (https://no-color.org). The theme is then always [Monochrome], which
+// tells feedback apart by text attributes instead of color.
+var noColor = os.Getenv("NO_COLOR") != ""
+
+// NoColor reports whether the terminal asked for output without color.
+func NoColor() bool {
+	return noColor
+}
+
+// accessible is the accessible setting: screen-reader friendly output with
+// ASCII operators, spelled-out unary operators, no animations and screens
+// laid out as one column of labeled lines.
+var accessible bool
+
+// Accessible reports whether accessible mode is on.
+func Accessible() bool {
+	return accessible
+}
+
+// SetAccessible turns accessible mode on or off.
+func SetAccessible(on bool) {
+	accessible = on
+}
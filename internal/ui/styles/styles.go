@@ -2,57 +2,143 @@ package styles
 
 import "github.com/charmbracelet/lipgloss"
 
-// ANSI color constants (standard 16-color palette for terminal compatibility)
-const (
-	colorRed         = lipgloss.Color("1")
-	colorGreen       = lipgloss.Color("2")
-	colorYellow      = lipgloss.Color("3")
-	colorMagenta     = lipgloss.Color("5")
-	colorCyan        = lipgloss.Color("6")
-	colorWhite       = lipgloss.Color("7")
-	colorBrightBlue  = lipgloss.Color("12")
-	colorBrightWhite = lipgloss.Color("15")
-)
-
-var (
+// Palette is the set of colors a theme is built from. A nil color leaves
+// the terminal's default foreground.
+type Palette struct {
+	Accent    lipgloss.TerminalColor // Focus markers, highlights
+	Correct   lipgloss.TerminalColor // Right answers, good results
+	Incorrect lipgloss.TerminalColor // Wrong answers, bad results
+	Warning   lipgloss.TerminalColor // Slow answers, multipliers, milestones
+	Special   lipgloss.TerminalColor // Rare streak tiers
+	Text      lipgloss.TerminalColor // Secondary text that should stay readable
+	Emphasis  lipgloss.TerminalColor // The large score
+	Brand     lipgloss.TerminalColor // Logo and screen titles
+	Border    lipgloss.TerminalColor // Box borders
+	Muted     lipgloss.TerminalColor // De-emphasized text; nil uses faint text
+}
+
+// Theme holds every style the UI renders with. Screens read styles from
+// [Current] so that changing the theme restyles the whole UI.
+type Theme struct {
+	Name string
+
 	// Base styles
-	Normal = lipgloss.NewStyle()
-	Bold   = lipgloss.NewStyle().Bold(true)
+	Normal lipgloss.Style
+	Bold   lipgloss.Style
 	// Dim is for de-emphasized UI chrome (borders, separators).
 	// Subtle is for secondary text content (descriptions, hints).
 	// Currently identical, but kept separate for future styling flexibility.
-	Dim    = lipgloss.NewStyle().Faint(true)
-	Subtle = lipgloss.NewStyle().Faint(true)
-	Accent = lipgloss.NewStyle().Foreground(colorCyan)
+	Dim    lipgloss.Style
+	Subtle lipgloss.Style
+	Accent lipgloss.Style
 
 	// Feedback (brief flashes)
-	Correct   = lipgloss.NewStyle().Foreground(colorGreen)
-	Incorrect = lipgloss.NewStyle().Foreground(colorRed)
+	Correct   lipgloss.Style
+	Incorrect lipgloss.Style
+	Slow      lipgloss.Style
 
 	// Selection
-	Selected   = lipgloss.NewStyle().Bold(true)
-	Unselected = lipgloss.NewStyle().Faint(true)
+	Selected   lipgloss.Style
+	Unselected lipgloss.Style
 
 	// Scoring - Score display
-	Score      = lipgloss.NewStyle().Bold(true)
-	ScoreLarge = lipgloss.NewStyle().Bold(true).Foreground(colorBrightWhite)
+	Score      lipgloss.Style
+	ScoreLarge lipgloss.Style
 
 	// Scoring - Multiplier
-	Multiplier = lipgloss.NewStyle().Foreground(colorYellow)
+	Multiplier lipgloss.Style
 
 	// Scoring - Streak tiers (progressively more intense)
-	StreakNone        = lipgloss.NewStyle().Faint(true)
-	StreakBuilding    = lipgloss.NewStyle().Foreground(colorWhite)
-	StreakActive      = lipgloss.NewStyle().Foreground(colorGreen)
-	StreakMax         = lipgloss.NewStyle().Foreground(colorGreen).Bold(true)
-	StreakBlazing     = lipgloss.NewStyle().Foreground(colorYellow).Bold(true)
-	StreakUnstoppable = lipgloss.NewStyle().Foreground(colorMagenta).Bold(true)
-	StreakLegendary   = lipgloss.NewStyle().Foreground(colorCyan).Bold(true)
+	StreakNone        lipgloss.Style
+	StreakBuilding    lipgloss.Style
+	StreakActive      lipgloss.Style
+	StreakMax         lipgloss.Style
+	StreakBlazing     lipgloss.Style
+	StreakUnstoppable lipgloss.Style
+	StreakLegendary   lipgloss.Style
 
 	// Scoring - Milestone announcements
-	Milestone = lipgloss.NewStyle().Bold(true).Foreground(colorYellow)
+	Milestone lipgloss.Style
 
 	// Branding
-	Logo    = lipgloss.NewStyle().Foreground(colorBrightBlue)
-	Tagline = lipgloss.NewStyle().Foreground(colorWhite)
-)
+	Logo    lipgloss.Style
+	Tagline lipgloss.Style
+
+	// Border is the color of box borders.
+	Border lipgloss.TerminalColor
+}
+
+// NewTheme builds a theme from a palette.
+func NewTheme(name string, p Palette) *Theme {
+	fg := func(c lipgloss.TerminalColor) lipgloss.Style {
+		if c == nil {
+			return lipgloss.NewStyle()
+		}
+		return lipgloss.NewStyle().Foreground(c)
+	}
+	muted := lipgloss.NewStyle().Faint(true)
+	if p.Muted != nil {
+		muted = fg(p.Muted)
+	}
+	border := p.Border
+	if border == nil {
+		border = lipgloss.NoColor{}
+	}
+
+	return &Theme{
+		Name: name,
+
+		Normal: lipgloss.NewStyle(),
+		Bold:   lipgloss.NewStyle().Bold(true),
+		Dim:    muted,
+		Subtle: muted,
+		Accent: fg(p.Accent),
+
+		Correct:   fg(p.Correct),
+		Incorrect: fg(p.Incorrect),
+		Slow:      fg(p.Warning),
+
+		Selected:   lipgloss.NewStyle().Bold(true),
+		Unselected: muted,
+
+		Score:      lipgloss.NewStyle().Bold(true),
+		ScoreLarge: fg(p.Emphasis).Bold(true),
+
+		Multiplier: fg(p.Warning),
+
+		StreakNone:        muted,
+		StreakBuilding:    fg(p.Text),
+		StreakActive:      fg(p.Correct),
+		StreakMax:         fg(p.Correct).Bold(true),
+		StreakBlazing:     fg(p.Warning).Bold(true),
+		StreakUnstoppable: fg(p.Special).Bold(true),
+		StreakLegendary:   fg(p.Accent).Bold(true),
+
+		Milestone: fg(p.Warning).Bold(true),
+
+		Logo:    fg(p.Brand),
+		Tagline: fg(p.Text),
+
+		Border: border,
+	}
+}
+
+// current is the active theme.
+var current = Dark()
+
+// Current returns the active theme.
+func Current() *Theme {
+	return current
+}
+
+// Use makes t the active theme. With NO_COLOR set, the monochrome theme
+// is used instead.
+func Use(t *Theme) {
+	if t == nil {
+		return
+	}
+	if noColor {
+		t = Monochrome()
+	}
+	current = t
+}
@@ -0,0 +1,154 @@
+package styles
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+// themeFileExt is the extension of user theme files.
+const themeFileExt = ".json"
+
+// ThemeFile is the format of a user theme file, themes/<name>.json in the
+// config directory. Colors not set are taken from the base theme.
+//
+//	{
+//	  "base": "dark",
+//	  "colors": {
+//	    "accent": "#00afd7",
+//	    "correct": "2",
+//	    "muted": "245"
+//	  }
+//	}
+//
+// Colors are "#rrggbb" true colors, which are approximated on terminals
+// with fewer colors, or ANSI color numbers from 0 to 255.
+type ThemeFile struct {
+	Base   string            `json:"base,omitempty"` // "dark" (default) or "light"
+	Colors map[string]string `json:"colors"`
+}
+
+// hexColor matches a "#rrggbb" color.
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// paletteColors maps theme file color names to palette fields.
+var paletteColors = map[string]func(p *Palette) *lipgloss.TerminalColor{
+	"accent":    func(p *Palette) *lipgloss.TerminalColor { return &p.Accent },
+	"correct":   func(p *Palette) *lipgloss.TerminalColor { return &p.Correct },
+	"incorrect": func(p *Palette) *lipgloss.TerminalColor { return &p.Incorrect },
+	"warning":   func(p *Palette) *lipgloss.TerminalColor { return &p.Warning },
+	"special":   func(p *Palette) *lipgloss.TerminalColor { return &p.Special },
+	"text":      func(p *Palette) *lipgloss.TerminalColor { return &p.Text },
+	"emphasis":  func(p *Palette) *lipgloss.TerminalColor { return &p.Emphasis },
+	"brand":     func(p *Palette) *lipgloss.TerminalColor { return &p.Brand },
+	"border":    func(p *Palette) *lipgloss.TerminalColor { return &p.Border },
+	"muted":     func(p *Palette) *lipgloss.TerminalColor { return &p.Muted },
+}
+
+// UserThemeNames returns the names of the theme files in the themes
+// directory, sorted. Files named like a built-in theme are ignored.
+func UserThemeNames() ([]string, error) {
+	dir, err := storage.ThemesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), themeFileExt)
+		if !ok || e.IsDir() || slices.Contains(BuiltinNames(), name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// LoadUserTheme reads the named theme from the themes directory.
+func LoadUserTheme(name string) (*Theme, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return nil, errors.New("invalid theme name")
+	}
+	dir, err := storage.ThemesDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name+themeFileExt))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no such theme (built-in: %s; user themes go in %s)",
+				strings.Join(BuiltinNames(), ", "), dir)
+		}
+		return nil, err
+	}
+	return ParseThemeFile(name, data)
+}
+
+// ParseThemeFile builds a theme from the contents of a theme file.
+func ParseThemeFile(name string, data []byte) (*Theme, error) {
+	var f ThemeFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid theme file: %w", err)
+	}
+
+	var p Palette
+	switch f.Base {
+	case "", ThemeDark:
+		p = darkPalette()
+	case ThemeLight:
+		p = lightPalette()
+	default:
+		return nil, fmt.Errorf("unknown base %q (valid: %s, %s)", f.Base, ThemeDark, ThemeLight)
+	}
+
+	for key, value := range f.Colors {
+		field, ok := paletteColors[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown color %q (valid: %s)", key, strings.Join(colorNames(), ", "))
+		}
+		c, err := parseColor(value)
+		if err != nil {
+			return nil, fmt.Errorf("color %q: %w", key, err)
+		}
+		*field(&p) = c
+	}
+	return NewTheme(name, p), nil
+}
+
+// parseColor parses a "#rrggbb" or ANSI 0-255 color.
+func parseColor(value string) (lipgloss.TerminalColor, error) {
+	if hexColor.MatchString(value) {
+		return lipgloss.Color(value), nil
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n <= 255 {
+		return lipgloss.Color(value), nil
+	}
+	return nil, fmt.Errorf("invalid color %q (use #rrggbb or 0-255)", value)
+}
+
+func colorNames() []string {
+	names := make([]string, 0, len(paletteColors))
+	for name := range paletteColors {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,143 @@
+package styles
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Built-in theme names.
+const (
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeMonochrome   = "monochrome"
+)
+
+// DefaultTheme is used when no theme is configured.
+const DefaultTheme = ThemeDark
+
+// builtinThemes lists the built-in themes in picker order.
+var builtinThemes = []struct {
+	name  string
+	build func() *Theme
+}{
+	{ThemeDark, Dark},
+	{ThemeLight, Light},
+	{ThemeHighContrast, HighContrast},
+	{ThemeMonochrome, Monochrome},
+}
+
+// BuiltinNames returns the names of the built-in themes.
+func BuiltinNames() []string {
+	names := make([]string, len(builtinThemes))
+	for i, b := range builtinThemes {
+		names[i] = b.name
+	}
+	return names
+}
+
+// Dark is the default theme: the standard 16-color palette, which
+// terminals map to their own color scheme.
+func Dark() *Theme {
+	return NewTheme(ThemeDark, darkPalette())
+}
+
+func darkPalette() Palette {
+	return Palette{
+		Accent:    lipgloss.Color("6"),
+		Correct:   lipgloss.Color("2"),
+		Incorrect: lipgloss.Color("1"),
+		Warning:   lipgloss.Color("3"),
+		Special:   lipgloss.Color("5"),
+		Text:      lipgloss.Color("7"),
+		Emphasis:  lipgloss.Color("15"),
+		Brand:     lipgloss.Color("12"),
+		Border:    lipgloss.Color("8"),
+	}
+}
+
+// Light is for light terminal backgrounds. It uses darker true colors
+// where the terminal supports them, with 256- and 16-color fallbacks.
+func Light() *Theme {
+	return NewTheme(ThemeLight, lightPalette())
+}
+
+func lightPalette() Palette {
+	return Palette{
+		Accent:    lipgloss.CompleteColor{TrueColor: "#0b7285", ANSI256: "30", ANSI: "6"},
+		Correct:   lipgloss.CompleteColor{TrueColor: "#2b8a3e", ANSI256: "28", ANSI: "2"},
+		Incorrect: lipgloss.CompleteColor{TrueColor: "#c92a2a", ANSI256: "160", ANSI: "1"},
+		Warning:   lipgloss.CompleteColor{TrueColor: "#b35900", ANSI256: "130", ANSI: "3"},
+		Special:   lipgloss.CompleteColor{TrueColor: "#862e9c", ANSI256: "90", ANSI: "5"},
+		Text:      lipgloss.CompleteColor{TrueColor: "#495057", ANSI256: "240", ANSI: "8"},
+		Emphasis:  lipgloss.CompleteColor{TrueColor: "#000000", ANSI256: "16", ANSI: "0"},
+		Brand:     lipgloss.CompleteColor{TrueColor: "#1c4fd8", ANSI256: "26", ANSI: "4"},
+		Border:    lipgloss.CompleteColor{TrueColor: "#adb5bd", ANSI256: "250", ANSI: "7"},
+	}
+}
+
+// HighContrast uses bright colors and avoids faint text, which many
+// terminals render with too little contrast.
+func HighContrast() *Theme {
+	return NewTheme(ThemeHighContrast, Palette{
+		Accent:    lipgloss.Color("14"),
+		Correct:   lipgloss.Color("10"),
+		Incorrect: lipgloss.Color("9"),
+		Warning:   lipgloss.Color("11"),
+		Special:   lipgloss.Color("13"),
+		Text:      lipgloss.Color("15"),
+		Emphasis:  lipgloss.Color("15"),
+		Brand:     lipgloss.Color("14"),
+		Border:    lipgloss.Color("15"),
+		Muted:     lipgloss.Color("7"),
+	})
+}
+
+// Monochrome uses no colors. Feedback is told apart by text attributes.
+func Monochrome() *Theme {
+	t := NewTheme(ThemeMonochrome, Palette{})
+	t.Accent = lipgloss.NewStyle().Bold(true)
+	t.Correct = lipgloss.NewStyle().Bold(true)
+	t.Incorrect = lipgloss.NewStyle().Underline(true)
+	t.Slow = lipgloss.NewStyle().Italic(true)
+	t.Logo = lipgloss.NewStyle().Bold(true)
+	return t
+}
+
+// Lookup returns the built-in or user theme with the given name.
+// An empty name selects [DefaultTheme].
+func Lookup(name string) (*Theme, error) {
+	if name == "" {
+		name = DefaultTheme
+	}
+	for _, b := range builtinThemes {
+		if b.name == name {
+			return b.build(), nil
+		}
+	}
+	t, err := LoadUserTheme(name)
+	if err != nil {
+		return nil, fmt.Errorf("theme %q: %w", name, err)
+	}
+	return t, nil
+}
+
+// Names returns the built-in theme names followed by the user themes.
+func Names() ([]string, error) {
+	names := BuiltinNames()
+	user, err := UserThemeNames()
+	return append(names, user...), err
+}
+
+// Apply makes the named theme active. If it cannot be loaded, the default
+// theme is used and the error is returned.
+func Apply(name string) error {
+	t, err := Lookup(name)
+	if err != nil {
+		Use(Dark())
+		return err
+	}
+	Use(t)
+	return nil
+}
@@ -0,0 +1,126 @@
+package styles
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/storage"
+)
+
+func TestLookupBuiltins(t *testing.T) {
+	for _, name := range BuiltinNames() {
+		theme, err := Lookup(name)
+		if err != nil {
+			t.Errorf("Lookup(%q) error = %v", name, err)
+			continue
+		}
+		if theme.Name != name {
+			t.Errorf("Lookup(%q).Name = %q", name, theme.Name)
+		}
+	}
+
+	theme, err := Lookup("")
+	if err != nil || theme.Name != DefaultTheme {
+		t.Errorf("Lookup(\"\") = %v, %v; want the default theme", theme, err)
+	}
+}
+
+func TestParseThemeFile(t *testing.T) {
+	theme, err := ParseThemeFile("ocean", []byte(`{"base": "light", "colors": {"accent": "#00afd7", "correct": "34"}}`))
+	if err != nil {
+		t.Fatalf("ParseThemeFile error = %v", err)
+	}
+	if got := theme.Accent.GetForeground(); got != lipgloss.Color("#00afd7") {
+		t.Errorf("accent = %v, want #00afd7", got)
+	}
+	if got := theme.Correct.GetForeground(); got != lipgloss.Color("34") {
+		t.Errorf("correct = %v, want 34", got)
+	}
+	if got, want := theme.Incorrect.GetForeground(), lightPalette().Incorrect; got != want {
+		t.Errorf("incorrect = %v, want the light base %v", got, want)
+	}
+
+	bad := []string{
+		`{"colors": {"accent": "cyan"}}`,
+		`{"colors": {"accent": "256"}}`,
+		`{"colors": {"background": "#000000"}}`,
+		`{"base": "solarized"}`,
+		`not json`,
+	}
+	for _, data := range bad {
+		if _, err := ParseThemeFile("bad", []byte(data)); err == nil {
+			t.Errorf("ParseThemeFile(%s) should fail", data)
+		}
+	}
+}
+
+func TestUserThemes(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	// No themes directory yet
+	names, err := Names()
+	if err != nil || !slices.Equal(names, BuiltinNames()) {
+		t.Fatalf("Names() = %v, %v; want only the built-in themes", names, err)
+	}
+
+	dir, err := storage.ThemesDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"ocean.json":  `{"colors": {"accent": "#00afd7"}}`,
+		"dark.json":   `{"colors": {"accent": "1"}}`, // shadowed by the built-in
+		"notes.txt":   `not a theme`,
+		"broken.json": `{"colors": {"accent": "cyan"}}`,
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names, err = Names()
+	want := append(BuiltinNames(), "broken", "ocean")
+	if err != nil || !slices.Equal(names, want) {
+		t.Errorf("Names() = %v, %v; want %v", names, err, want)
+	}
+
+	if theme, err := Lookup("ocean"); err != nil || theme.Name != "ocean" {
+		t.Errorf("Lookup(ocean) = %v, %v", theme, err)
+	}
+	if _, err := Lookup("broken"); err == nil {
+		t.Error("Lookup(broken) should fail")
+	}
+	if _, err := Lookup("../ocean"); err == nil {
+		t.Error("Lookup(../ocean) should fail")
+	}
+
+	defer Use(Dark())
+	if err := Apply("missing"); err == nil {
+		t.Error("Apply(missing) should fail")
+	}
+	if Current().Name != ThemeDark {
+		t.Errorf("after a failed Apply, theme = %q, want %q", Current().Name, ThemeDark)
+	}
+	if err := Apply("ocean"); err != nil || Current().Name != "ocean" {
+		t.Errorf("Apply(ocean) = %v, theme = %q", err, Current().Name)
+	}
+}
+
+func TestNoColorForcesMonochrome(t *testing.T) {
+	defer func(v bool) { noColor = v; Use(Dark()) }(noColor)
+
+	noColor = true
+	Use(Light())
+	if Current().Name != ThemeMonochrome {
+		t.Errorf("with NO_COLOR, theme = %q, want %q", Current().Name, ThemeMonochrome)
+	}
+}
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/gurselcakar/arithmego/internal/ui/components"
+	"github.com/gurselcakar/arithmego/internal/ui/styles"
+	"github.com/gurselcakar/arithmego/internal/wrap"
+)
+
+// WrapOnExit controls what the TUI does when the wrapped command exits.
+type WrapOnExit int
+
+const (
+	// WrapOnExitNotify flashes the status line and keeps the game running.
+	WrapOnExitNotify WrapOnExit = iota
+	// WrapOnExitQuit ends the session and leaves the TUI.
+	WrapOnExitQuit
+)
+
+// wrapStatusHeight is the number of lines reserved for the status line.
+const wrapStatusHeight = 1
+
+// wrapFlashDuration is how long the status line is highlighted after the
+// command exits.
+const wrapFlashDuration = 3 * time.Second
+
+// WrapModel runs the app with a status line for a wrapped command below it.
+type WrapModel struct {
+	app    *App
+	child  *wrap.Child
+	onExit WrapOnExit
+
+	width      int
+	height     int
+	exited     bool
+	flashUntil time.Time
+}
+
+// childExitedMsg is sent when the wrapped command exits.
+type childExitedMsg struct{}
+
+// wrapTickMsg refreshes the status line.
+type wrapTickMsg time.Time
+
+// NewWrap creates a model that shows app above a status line for child.
+func NewWrap(app *App, child *wrap.Child, onExit WrapOnExit) *WrapModel {
+	return &WrapModel{app: app, child: child, onExit: onExit}
+}
+
+// Init starts the app and begins watching the child.
+func (m *WrapModel) Init() tea.Cmd {
+	return tea.Batch(m.app.Init(), m.waitForChild(), wrapTick())
+}
+
+func (m *WrapModel) waitForChild() tea.Cmd {
+	return func() tea.Msg {
+		<-m.child.Done()
+		return childExitedMsg{}
+	}
+}
+
+func wrapTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return wrapTickMsg(t)
+	})
+}
+
+// Update handles child events and passes everything else to the app.
+func (m *WrapModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		// The app gets the space above the status line.
+		msg.Height -= wrapStatusHeight
+		if msg.Height < 1 {
+			msg.Height = 1
+		}
+		_, cmd := m.app.Update(msg)
+		return m, cmd
+
+	case childExitedMsg:
+		m.exited = true
+		if m.onExit == WrapOnExitQuit {
+			// A game in progress counts: save it before leaving.
+			m.app.EndGame()
+			return m, tea.Quit
+		}
+		m.flashUntil = time.Now().Add(wrapFlashDuration)
+		return m, nil
+
+	case wrapTickMsg:
+		if m.exited && time.Now().After(m.flashUntil) {
+			return m, nil // Status is final; stop refreshing.
+		}
+		return m, wrapTick()
+	}
+
+	_, cmd := m.app.Update(msg)
+	return m, cmd
+}
+
+// View renders the app with the status line at the bottom.
+func (m *WrapModel) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left, m.app.View(), m.statusLine())
+}
+
+// statusLine renders the command, its state and its last line of output.
+func (m *WrapModel) statusLine() string {
+	status := m.child.Status()
+
+	var icon string
+	var style lipgloss.Style
+	var state string
+	switch {
+	case status.Running:
+		icon, style, state = "▶", styles.Current().Accent, "running "+components.FormatTimer(status.Elapsed)
+	case status.ExitCode == 0:
+		icon, style, state = "✓", styles.Current().Correct, "done in "+components.FormatTimer(status.Elapsed)
+	default:
+		icon, style = "✗", styles.Current().Incorrect
+		state = "failed (exit " + strconv.Itoa(status.ExitCode) + ") after " + components.FormatTimer(status.Elapsed)
+	}
+
+	if !status.Running && time.Now().Before(m.flashUntil) {
+		style = style.Reverse(true).Bold(true)
+	}
+
+	line := style.Render(icon+" "+m.child.CommandLine()+" · "+state) + styles.Current().Dim.Render(lastLineSuffix(status))
+	if m.width > 0 {
+		line = lipgloss.NewStyle().MaxWidth(m.width).Render(line)
+	}
+	return line
+}
+
+// lastLineSuffix returns the child's last line of output while it runs.
+func lastLineSuffix(status wrap.Status) string {
+	if status.Running && status.LastLine != "" {
+		return "  " + status.LastLine
+	}
+	if !status.Running {
+		return "  output is shown when you quit"
+	}
+	return ""
+}
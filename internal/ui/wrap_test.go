@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/modes"
+	"github.com/gurselcakar/arithmego/internal/storage"
+	"github.com/gurselcakar/arithmego/internal/wrap"
+)
+
+func TestWrapQuitOnExitSavesGame(t *testing.T) {
+	storage.SetConfigDirForTesting(t.TempDir())
+	defer storage.SetConfigDirForTesting("")
+
+	modes.RegisterPresets()
+	app := New()
+	app.currentMode, _ = modes.Get(modes.IDAddition)
+	app.lastDifficulty = game.Easy
+	app.lastDuration = time.Minute
+	_, cmd := app.startGame()
+	if app.screen != ScreenGame || cmd == nil {
+		t.Fatalf("game did not start: screen %v", app.screen)
+	}
+	app.session.SubmitAnswer(app.session.Current.Answer)
+
+	// The test binary with no tests to run exits straight away.
+	child, err := wrap.Start([]string{os.Args[0], "-test.run=^$"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-child.Done()
+
+	m := NewWrap(app, child, WrapOnExitQuit)
+	_, cmd = m.Update(childExitedMsg{})
+	if cmd == nil {
+		t.Fatal("quit on exit returned no command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("quit on exit did not quit")
+	}
+	if app.screen != ScreenResults {
+		t.Errorf("screen = %v, want results", app.screen)
+	}
+
+	stats, err := storage.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Sessions) != 1 {
+		t.Fatalf("stored %d sessions, want 1", len(stats.Sessions))
+	}
+	if got := stats.Sessions[0]; got.Mode != "Addition" || got.QuestionsCorrect != 1 {
+		t.Errorf("stored session = %s with %d correct, want Addition with 1", got.Mode, got.QuestionsCorrect)
+	}
+}
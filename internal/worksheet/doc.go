@@ -0,0 +1,21 @@
+// Package worksheet builds printable sheets of practice questions.
+//
+// [New] draws questions from a registered generator through a
+// [game.QuestionPool], so a sheet has no duplicates. Asking for more
+// questions than the generator has unique ones is an error, unless
+// [Options].AllowFewer is set, which stops the sheet short instead. Every
+// sheet records its seed; building a sheet with the same generator,
+// difficulty, count and seed gives the same questions:
+//
+//	sheet, err := worksheet.New(g, worksheet.Options{
+//	    Title:      "Addition",
+//	    Difficulty: game.Easy,
+//	    Count:      50,
+//	    Seed:       42,
+//	})
+//	err = sheet.Write(os.Stdout, worksheet.FormatHTML, true)
+//
+// Sheets render as plain text, Markdown or a standalone HTML page. The
+// optional answer key starts on a new page (form feed in text, a page break
+// in HTML).
+package worksheet
@@ -0,0 +1,135 @@
+package worksheet
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// textColumns is the number of question columns in plain text.
+const textColumns = 2
+
+// answerBlank is the space left for writing an answer.
+const answerBlank = "________"
+
+// Write renders the sheet in format f. With answers, an answer key follows
+// the questions on a new page.
+func (s *Sheet) Write(w io.Writer, f Format, answers bool) error {
+	var b strings.Builder
+	switch f {
+	case FormatText:
+		s.writeText(&b, answers)
+	case FormatMarkdown:
+		s.writeMarkdown(&b, answers)
+	case FormatHTML:
+		s.writeHTML(&b, answers)
+	default:
+		return fmt.Errorf("unknown format %q", f)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// subtitle describes the sheet, e.g. "Easy · 50 questions · seed 42".
+func (s *Sheet) subtitle() string {
+	return fmt.Sprintf("%s · %d questions · seed %d", s.Difficulty, len(s.Questions), s.Seed)
+}
+
+func (s *Sheet) writeText(b *strings.Builder, answers bool) {
+	fmt.Fprintf(b, "%s\n%s\n\nName: ____________________   Date: ____________\n\n", s.Title, s.subtitle())
+
+	items := make([]string, len(s.Questions))
+	for i, q := range s.Questions {
+		items[i] = fmt.Sprintf("%3d.  %s = %s", i+1, q.Display, answerBlank)
+	}
+	writeColumns(b, items)
+
+	if !answers {
+		return
+	}
+	fmt.Fprintf(b, "\f%s: answer key\n%s\n\n", s.Title, s.subtitle())
+	for i, q := range s.Questions {
+		items[i] = fmt.Sprintf("%3d.  %s = %d", i+1, q.Display, q.Answer)
+	}
+	writeColumns(b, items)
+}
+
+// writeColumns lays items out in textColumns columns, filling down each
+// column first so numbering reads top to bottom.
+func writeColumns(b *strings.Builder, items []string) {
+	width := 0
+	for _, item := range items {
+		width = max(width, utf8.RuneCountInString(item))
+	}
+	rows := (len(items) + textColumns - 1) / textColumns
+	for r := 0; r < rows; r++ {
+		var line strings.Builder
+		for c := 0; c < textColumns; c++ {
+			i := c*rows + r
+			if i >= len(items) {
+				break
+			}
+			if c > 0 {
+				line.WriteString("    ")
+			}
+			line.WriteString(items[i])
+			if c < textColumns-1 {
+				line.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(items[i])))
+			}
+		}
+		b.WriteString(strings.TrimRight(line.String(), " "))
+		b.WriteString("\n\n")
+	}
+}
+
+func (s *Sheet) writeMarkdown(b *strings.Builder, answers bool) {
+	fmt.Fprintf(b, "# %s\n\n%s\n\nName: ____________________ Date: ____________\n\n", s.Title, s.subtitle())
+	for i, q := range s.Questions {
+		fmt.Fprintf(b, "%d. %s = %s\n", i+1, markdownEscape(q.Display), `\_\_\_\_\_\_\_\_`)
+	}
+
+	if !answers {
+		return
+	}
+	b.WriteString("\n---\n\n## Answer key\n\n")
+	for i, q := range s.Questions {
+		fmt.Fprintf(b, "%d. %s = **%d**\n", i+1, markdownEscape(q.Display), q.Answer)
+	}
+}
+
+// markdownEscape escapes characters that Markdown would treat as emphasis.
+func markdownEscape(s string) string {
+	return strings.NewReplacer(`*`, `\*`, `_`, `\_`).Replace(s)
+}
+
+// htmlStyle lays questions out in columns and starts the answer key on a new page.
+const htmlStyle = `body { font-family: sans-serif; margin: 2rem; }
+h1 { margin-bottom: 0.2rem; }
+.meta { color: #555; margin-top: 0; }
+.name { margin: 1.5rem 0; }
+ol { columns: 2; column-gap: 3rem; font-size: 1.2rem; }
+li { margin-bottom: 1.2rem; break-inside: avoid; }
+.answers { page-break-before: always; break-before: page; }
+.answers li { margin-bottom: 0.4rem; }`
+
+func (s *Sheet) writeHTML(b *strings.Builder, answers bool) {
+	title := html.EscapeString(s.Title)
+	fmt.Fprintf(b, "<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n", title, htmlStyle)
+	fmt.Fprintf(b, "<h1>%s</h1>\n<p class=\"meta\">%s</p>\n", title, html.EscapeString(s.subtitle()))
+	b.WriteString("<p class=\"name\">Name: ____________________ Date: ____________</p>\n<ol>\n")
+	for _, q := range s.Questions {
+		fmt.Fprintf(b, "<li>%s = %s</li>\n", html.EscapeString(q.Display), answerBlank)
+	}
+	b.WriteString("</ol>\n")
+
+	if answers {
+		fmt.Fprintf(b, "<section class=\"answers\">\n<h2>%s: answer key</h2>\n<ol>\n", title)
+		for _, q := range s.Questions {
+			fmt.Fprintf(b, "<li>%s = <strong>%d</strong></li>\n", html.EscapeString(q.Display), q.Answer)
+		}
+		b.WriteString("</ol>\n</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+}
@@ -0,0 +1,101 @@
+package worksheet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+)
+
+// MaxCount bounds the number of questions on one sheet.
+const MaxCount = 500
+
+// Format is an output format for a sheet.
+type Format string
+
+// Supported formats.
+const (
+	FormatText     Format = "txt"
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+)
+
+// AllFormats returns the supported formats.
+func AllFormats() []Format {
+	return []Format{FormatText, FormatMarkdown, FormatHTML}
+}
+
+// ParseFormat parses a format name. "text" and "md" are accepted as aliases.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "txt", "text":
+		return FormatText, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "html":
+		return FormatHTML, nil
+	}
+	return "", fmt.Errorf("unknown format %q (valid: txt, markdown, html)", s)
+}
+
+// Options configures a sheet.
+type Options struct {
+	Title      string // Heading, usually the mode name
+	Difficulty game.Difficulty
+	Count      int   // Number of questions, 1 to MaxCount
+	Seed       int64 // Random seed; 0 picks one from the clock
+	// AllowFewer stops at the generator's unique questions when there are
+	// fewer than Count, instead of returning an error.
+	AllowFewer bool
+}
+
+// Sheet is a generated worksheet.
+type Sheet struct {
+	Title      string
+	Difficulty game.Difficulty
+	Seed       int64
+	Questions  []*game.Question
+}
+
+// New generates a sheet of questions from g.
+// It reseeds the shared question generator (see [game.Seed]).
+func New(g game.Generator, opts Options) (*Sheet, error) {
+	if opts.Count < 1 || opts.Count > MaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", MaxCount)
+	}
+
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()%999_999 + 1 // Short enough to type back in
+	}
+	game.Seed(seed)
+
+	sheet := &Sheet{
+		Title:      opts.Title,
+		Difficulty: opts.Difficulty,
+		Seed:       seed,
+		Questions:  make([]*game.Question, 0, opts.Count),
+	}
+
+	pool := game.NewQuestionPool(g, opts.Difficulty)
+	seen := make(map[string]bool, opts.Count)
+	for len(sheet.Questions) < opts.Count {
+		q := pool.Next()
+		if q == nil {
+			return nil, errors.New("generator produced no questions")
+		}
+		// The pool only repeats once it has run out of unique questions
+		if seen[q.Key] {
+			if opts.AllowFewer {
+				break
+			}
+			return nil, fmt.Errorf("only %d different questions are available at this difficulty; use a count of %d or less",
+				len(sheet.Questions), len(sheet.Questions))
+		}
+		seen[q.Key] = true
+		sheet.Questions = append(sheet.Questions, q)
+	}
+	return sheet, nil
+}
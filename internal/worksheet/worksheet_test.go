@@ -0,0 +1,136 @@
+package worksheet
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gurselcakar/arithmego/internal/game"
+	"github.com/gurselcakar/arithmego/internal/game/gen"
+)
+
+func newTestSheet(t *testing.T, label string, count int, seed int64) *Sheet {
+	t.Helper()
+	g, ok := gen.Get(label)
+	if !ok {
+		t.Fatalf("generator %q not registered", label)
+	}
+	sheet, err := New(g, Options{Title: label, Difficulty: game.Medium, Count: count, Seed: seed})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return sheet
+}
+
+func TestNewIsReproducible(t *testing.T) {
+	for _, label := range []string{"Addition", "Mixed Basics", "Anything Goes"} {
+		a := newTestSheet(t, label, 30, 42)
+		b := newTestSheet(t, label, 30, 42)
+		for i := range a.Questions {
+			if a.Questions[i].Key != b.Questions[i].Key {
+				t.Fatalf("%s: question %d differs with the same seed: %q vs %q",
+					label, i+1, a.Questions[i].Display, b.Questions[i].Display)
+			}
+		}
+	}
+}
+
+func TestNewHasNoDuplicates(t *testing.T) {
+	sheet := newTestSheet(t, "Multiplication", 50, 7)
+	if len(sheet.Questions) != 50 {
+		t.Fatalf("got %d questions, want 50", len(sheet.Questions))
+	}
+	seen := make(map[string]bool)
+	for _, q := range sheet.Questions {
+		if seen[q.Key] {
+			t.Errorf("duplicate question %q", q.Display)
+		}
+		seen[q.Key] = true
+	}
+}
+
+// smallGen knows only three questions.
+type smallGen struct{ n int }
+
+func (g *smallGen) Generate(diff game.Difficulty) *game.Question {
+	g.n = g.n%3 + 1
+	return &game.Question{Key: strconv.Itoa(g.n), Answer: g.n, Display: strconv.Itoa(g.n) + " + 0"}
+}
+
+func (g *smallGen) Label() string { return "Small" }
+
+func TestNewRejectsCountAboveUniqueQuestions(t *testing.T) {
+	if _, err := New(&smallGen{}, Options{Count: 3, Seed: 1}); err != nil {
+		t.Fatalf("New(count=3) error = %v", err)
+	}
+	_, err := New(&smallGen{}, Options{Count: 4, Seed: 1})
+	if err == nil || !strings.Contains(err.Error(), "count of 3 or less") {
+		t.Errorf("New(count=4) error = %v, want the maximum of 3", err)
+	}
+
+	sheet, err := New(&smallGen{}, Options{Count: 40, Seed: 1, AllowFewer: true})
+	if err != nil {
+		t.Fatalf("New(count=40, AllowFewer) error = %v", err)
+	}
+	if len(sheet.Questions) != 3 {
+		t.Errorf("AllowFewer sheet has %d questions, want 3", len(sheet.Questions))
+	}
+}
+
+func TestNewPicksSeed(t *testing.T) {
+	sheet := newTestSheet(t, "Addition", 1, 0)
+	if sheet.Seed == 0 {
+		t.Error("Seed = 0, want a generated seed")
+	}
+}
+
+func TestNewRejectsBadCount(t *testing.T) {
+	g, _ := gen.Get("Addition")
+	for _, count := range []int{0, -1, MaxCount + 1} {
+		if _, err := New(g, Options{Count: count}); err == nil {
+			t.Errorf("New(count=%d) should fail", count)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	sheet := newTestSheet(t, "Addition", 5, 3)
+	answer := strconv.Itoa(sheet.Questions[0].Answer)
+
+	for _, f := range AllFormats() {
+		var without, with strings.Builder
+		if err := sheet.Write(&without, f, false); err != nil {
+			t.Fatalf("Write(%s) error = %v", f, err)
+		}
+		if err := sheet.Write(&with, f, true); err != nil {
+			t.Fatalf("Write(%s, answers) error = %v", f, err)
+		}
+
+		if !strings.Contains(without.String(), "seed 3") {
+			t.Errorf("%s: sheet does not show its seed", f)
+		}
+		if strings.Contains(without.String(), "= "+answer) || strings.Contains(without.String(), "**"+answer) {
+			t.Errorf("%s: answers shown without --answers", f)
+		}
+		if !strings.Contains(with.String(), answer) {
+			t.Errorf("%s: answer key missing answer %s", f, answer)
+		}
+	}
+
+	var b strings.Builder
+	if err := sheet.Write(&b, FormatText, true); err != nil || !strings.Contains(b.String(), "\f") {
+		t.Error("text answer key should start on a new page")
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]Format{"txt": FormatText, "TEXT": FormatText, "md": FormatMarkdown, "markdown": FormatMarkdown, "html": FormatHTML}
+	for input, want := range tests {
+		if got, err := ParseFormat(input); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("pdf"); err == nil {
+		t.Error("ParseFormat(\"pdf\") should fail")
+	}
+}
@@ -0,0 +1,216 @@
+package wrap
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ExitCodeFailedToRun is reported when the command could not be run.
+const ExitCodeFailedToRun = 127
+
+// MaxOutput is how much of the command's output is kept in memory. Older
+// output is dropped; the log file keeps all of it.
+const MaxOutput = 1 << 20
+
+// lastLineWindow is how far back from the end of the output Write looks for
+// the last line.
+const lastLineWindow = 4096
+
+// Status is a snapshot of the child's state.
+type Status struct {
+	Running  bool
+	ExitCode int           // Valid once Running is false
+	Err      error         // Non-nil if the command failed to run or was killed
+	Elapsed  time.Duration // Time since start, or total run time once finished
+	LastLine string        // Last non-empty line of output
+}
+
+// Child is a running (or finished) wrapped command.
+type Child struct {
+	cmd   *exec.Cmd
+	args  []string
+	start time.Time
+	done  chan struct{}
+	log   *os.File
+
+	mu       sync.Mutex
+	output   []byte // Tail of the output, at most 2*MaxOutput bytes
+	dropped  bool   // Output was dropped from the front
+	last     string // Last non-empty line, tracked by Write
+	finished time.Time
+	exitCode int
+	err      error
+}
+
+// Start runs args[0] with args[1:]. The last [MaxOutput] bytes of output are
+// kept in memory and, if logPath is non-empty, all of it is written to that
+// file as it arrives.
+func Start(args []string, logPath string) (*Child, error) {
+	if len(args) == 0 {
+		return nil, errors.New("no command given")
+	}
+
+	c := &Child{
+		args: args,
+		done: make(chan struct{}),
+	}
+
+	if logPath != "" {
+		f, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, err
+		}
+		c.log = f
+	}
+
+	c.cmd = exec.Command(args[0], args[1:]...)
+	c.cmd.Stdin = nil // The terminal belongs to the game.
+	c.cmd.Stdout = c
+	c.cmd.Stderr = c
+
+	c.start = time.Now()
+	if err := c.cmd.Start(); err != nil {
+		c.closeLog()
+		return nil, err
+	}
+
+	go c.wait()
+	return c, nil
+}
+
+// wait records the exit status and closes done.
+func (c *Child) wait() {
+	err := c.cmd.Wait()
+
+	c.mu.Lock()
+	c.finished = time.Now()
+	c.exitCode = 0
+	if err != nil {
+		var exitErr *exec.ExitError
+		switch {
+		case errors.As(err, &exitErr) && exitErr.ExitCode() >= 0:
+			c.exitCode = exitErr.ExitCode()
+		case errors.As(err, &exitErr) && signaled(exitErr) > 0:
+			// Shell convention: 128 + signal number.
+			c.exitCode = 128 + signaled(exitErr)
+			c.err = err
+		default:
+			c.exitCode = ExitCodeFailedToRun
+			c.err = err
+		}
+	}
+	c.mu.Unlock()
+
+	c.closeLog()
+	close(c.done)
+}
+
+func (c *Child) closeLog() {
+	if c.log != nil {
+		_ = c.log.Close()
+	}
+}
+
+// Write captures output from the child. It implements io.Writer.
+func (c *Child) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.output = append(c.output, p...)
+	// Trim only once the buffer doubles, so the copy is amortised.
+	if len(c.output) > 2*MaxOutput {
+		c.output = append(c.output[:0], c.output[len(c.output)-MaxOutput:]...)
+		c.dropped = true
+	}
+
+	// Blank writes keep the previous line.
+	if line := lastLine(c.output[max(0, len(c.output)-lastLineWindow):]); line != "" {
+		c.last = line
+	}
+
+	if c.log != nil {
+		// Log failures must not stop the child.
+		_, _ = c.log.Write(p)
+	}
+	return len(p), nil
+}
+
+// Done is closed when the command exits.
+func (c *Child) Done() <-chan struct{} {
+	return c.done
+}
+
+// CommandLine returns the command as typed, for display.
+func (c *Child) CommandLine() string {
+	quoted := make([]string, len(c.args))
+	for i, a := range c.args {
+		if a == "" || strings.ContainsAny(a, " \t\"'") {
+			a = strconv.Quote(a)
+		}
+		quoted[i] = a
+	}
+	return strings.Join(quoted, " ")
+}
+
+// Status returns the current state of the command.
+func (c *Child) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := Status{
+		Running:  c.finished.IsZero(),
+		ExitCode: c.exitCode,
+		Err:      c.err,
+		LastLine: c.last,
+	}
+	if s.Running {
+		s.Elapsed = time.Since(c.start)
+	} else {
+		s.Elapsed = c.finished.Sub(c.start)
+	}
+	return s
+}
+
+// WriteOutput copies the last [MaxOutput] bytes the command has printed so
+// far to w, after a note if earlier output was dropped.
+func (c *Child) WriteOutput(w io.Writer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out, dropped := c.output, c.dropped
+	if len(out) > MaxOutput {
+		out, dropped = out[len(out)-MaxOutput:], true
+	}
+	if dropped {
+		if _, err := io.WriteString(w, "[earlier output dropped]\n"); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(out)
+	return err
+}
+
+// lastLine returns the last non-empty line of output, without trailing
+// carriage returns (progress bars rewrite lines with \r).
+func lastLine(b []byte) string {
+	text := strings.TrimRight(string(b), "\r\n\t ")
+	if i := strings.LastIndexAny(text, "\r\n"); i >= 0 {
+		text = text[i+1:]
+	}
+	return strings.TrimSpace(text)
+}
+
+// signaled returns the signal that killed the process, or 0.
+func signaled(err *exec.ExitError) int {
+	if ws, ok := err.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		return int(ws.Signal())
+	}
+	return 0
+}
@@ -0,0 +1,143 @@
+package wrap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestHelperProcess is not a real test. It is the child command started by
+// the tests below, so they don't depend on a shell being installed.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("ARITHMEGO_WRAP_HELPER") != "1" {
+		return
+	}
+	fmt.Println("building...")
+	fmt.Fprintln(os.Stderr, "warning: something")
+	fmt.Println("last line")
+	os.Exit(3)
+}
+
+func startHelper(t *testing.T, logPath string) *Child {
+	t.Helper()
+	t.Setenv("ARITHMEGO_WRAP_HELPER", "1")
+	c, err := Start([]string{os.Args[0], "-test.run=^TestHelperProcess$"}, logPath)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	return c
+}
+
+func waitDone(t *testing.T, c *Child) {
+	t.Helper()
+	select {
+	case <-c.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("child did not exit")
+	}
+}
+
+func TestStart_CapturesOutputAndExitCode(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "wrap.log")
+	c := startHelper(t, logPath)
+	waitDone(t, c)
+
+	status := c.Status()
+	if status.Running {
+		t.Error("Status().Running = true after exit")
+	}
+	if status.ExitCode != 3 {
+		t.Errorf("ExitCode = %d, want 3", status.ExitCode)
+	}
+	if status.Err != nil {
+		t.Errorf("Err = %v, want nil for a normal exit", status.Err)
+	}
+	if status.LastLine != "last line" {
+		t.Errorf("LastLine = %q, want %q", status.LastLine, "last line")
+	}
+
+	var out strings.Builder
+	if err := c.WriteOutput(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"building...", "warning: something", "last line"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q: %q", want, out.String())
+		}
+	}
+
+	logged, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(logged) != out.String() {
+		t.Errorf("log = %q, want %q", logged, out.String())
+	}
+}
+
+func TestStart_MissingCommand(t *testing.T) {
+	if _, err := Start(nil, ""); err == nil {
+		t.Error("Start(nil) should fail")
+	}
+	if _, err := Start([]string{"arithmego-no-such-command"}, ""); err == nil {
+		t.Error("Start() of a missing command should fail")
+	}
+}
+
+func TestWrite_KeepsTail(t *testing.T) {
+	c := &Child{}
+	line := []byte(strings.Repeat("x", 99) + "\n")
+	for i := 0; i < 3*MaxOutput/len(line); i++ {
+		_, _ = c.Write(line)
+	}
+	_, _ = c.Write([]byte("done\n\n"))
+
+	if len(c.output) > 2*MaxOutput {
+		t.Errorf("buffer holds %d bytes, want at most %d", len(c.output), 2*MaxOutput)
+	}
+	if got := c.Status().LastLine; got != "done" {
+		t.Errorf("LastLine = %q, want %q", got, "done")
+	}
+
+	var out strings.Builder
+	if err := c.WriteOutput(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "[earlier output dropped]\n") {
+		t.Errorf("output does not start with the dropped note: %.40q", out.String())
+	}
+	if !strings.HasSuffix(out.String(), "done\n\n") {
+		t.Errorf("output does not end with the latest write")
+	}
+	if n := out.Len(); n > MaxOutput+len("[earlier output dropped]\n") {
+		t.Errorf("WriteOutput wrote %d bytes, want at most %d", n, MaxOutput)
+	}
+}
+
+func TestLastLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"one\ntwo\n", "two"},
+		{"one\ntwo\n\n\n", "two"},
+		{"progress 10%\rprogress 50%\r", "progress 50%"},
+		{"  indented  \n", "indented"},
+	}
+	for _, tt := range tests {
+		if got := lastLine([]byte(tt.in)); got != tt.want {
+			t.Errorf("lastLine(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCommandLine(t *testing.T) {
+	c := &Child{args: []string{"go", "test", "-run", "Test Foo"}}
+	if got, want := c.CommandLine(), `go test -run "Test Foo"`; got != want {
+		t.Errorf("CommandLine() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,12 @@
+// Package wrap runs a child command in the background while the game is played.
+//
+// [Start] launches the command with stdin detached and captures its combined
+// stdout and stderr: the tail in memory and, optionally, all of it in a log
+// file. The UI polls [Child.Status] for a status line and waits on
+// [Child.Done] to learn when the command finished:
+//
+//	child, err := wrap.Start([]string{"make", "test"}, logPath)
+//	...
+//	<-child.Done()
+//	os.Exit(child.Status().ExitCode)
+package wrap